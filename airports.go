@@ -0,0 +1,135 @@
+package skyscanner
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const earthRadiusKm = 6371.0088
+
+//go:embed airports.csv
+var airportsCSV string
+
+var (
+	defaultAirports     AirportTable
+	defaultAirportsOnce sync.Once
+)
+
+// Airport contains airport location data
+type Airport struct {
+	IATA      string  `json:"iata"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// Timezone is an IANA time zone name, e.g. Europe/London
+	Timezone string `json:"timezone"`
+}
+
+// AirportTable maps IATA codes to airports
+type AirportTable map[string]Airport
+
+// DefaultAirports returns a copy of the embedded table of major airports.
+// The copy can be extended with the airports missing in it
+func DefaultAirports() AirportTable {
+	defaultAirportsOnce.Do(func() {
+		defaultAirports = parseAirports(airportsCSV)
+	})
+
+	t := make(AirportTable, len(defaultAirports))
+	for k, v := range defaultAirports {
+		t[k] = v
+	}
+
+	return t
+}
+
+// Lookup returns the airport by its IATA code
+func (t AirportTable) Lookup(iata string) (Airport, bool) {
+	a, ok := t[strings.ToUpper(iata)]
+	return a, ok
+}
+
+// Distance returns the great-circle distance between the airports in kilometers
+func (t AirportTable) Distance(fromIATA, toIATA string) (float64, bool) {
+	from, ok := t.Lookup(fromIATA)
+	if !ok {
+		return 0, false
+	}
+	to, ok := t.Lookup(toIATA)
+	if !ok {
+		return 0, false
+	}
+
+	return GreatCircleDistance(from, to), true
+}
+
+// airportZones loads and caches time zones of the airports
+type airportZones struct {
+	airports AirportTable
+	zones    map[string]*time.Location
+}
+
+func newAirportZones(airports AirportTable) *airportZones {
+	return &airportZones{airports: airports, zones: make(map[string]*time.Location)}
+}
+
+// location returns the time zone of the airport place or nil if it is unknown or cannot be loaded
+func (z *airportZones) location(p Place) *time.Location {
+	a, ok := z.airports.Lookup(placeIATA(p))
+	if !ok || a.Timezone == "" {
+		return nil
+	}
+	if loc, ok := z.zones[a.Timezone]; ok {
+		return loc
+	}
+
+	loc, err := time.LoadLocation(a.Timezone)
+	if err != nil {
+		loc = nil
+	}
+	z.zones[a.Timezone] = loc
+
+	return loc
+}
+
+// GreatCircleDistance returns the great-circle distance between the airports in kilometers
+func GreatCircleDistance(a, b Airport) float64 {
+	lat1, lat2 := radians(a.Latitude), radians(b.Latitude)
+	dLat := lat2 - lat1
+	dLon := radians(b.Longitude - a.Longitude)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func parseAirports(data string) AirportTable {
+	t := make(AirportTable)
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		panic("invalid embedded airports table: " + err.Error())
+	}
+
+	for _, rec := range records[1:] {
+		lat, err := strconv.ParseFloat(rec[1], 64)
+		if err != nil {
+			panic("invalid embedded airports table: " + err.Error())
+		}
+		lon, err := strconv.ParseFloat(rec[2], 64)
+		if err != nil {
+			panic("invalid embedded airports table: " + err.Error())
+		}
+
+		t[rec[0]] = Airport{IATA: rec[0], Latitude: lat, Longitude: lon, Timezone: rec[3]}
+	}
+
+	return t
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
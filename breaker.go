@@ -0,0 +1,330 @@
+package skyscanner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	CircuitClosed   CircuitState = "closed"
+	CircuitOpen     CircuitState = "open"
+	CircuitHalfOpen CircuitState = "half_open"
+
+	EndpointGroupLivePricing EndpointGroup = "live_pricing"
+	EndpointGroupCulture     EndpointGroup = "culture"
+	EndpointGroupAutoSuggest EndpointGroup = "autosuggest"
+
+	defaultBreakerFailures    = 5
+	defaultBreakerMinRequests = 20
+	defaultBreakerWindow      = time.Minute
+	defaultBreakerOpenTimeout = 30 * time.Second
+	defaultBreakerProbes      = 1
+)
+
+type CircuitState string
+type EndpointGroup string
+
+type circuitResult int
+
+const (
+	circuitSuccess circuitResult = iota
+	circuitFailure
+	// circuitIgnored is a request cancelled by the caller, it does not affect the circuit
+	circuitIgnored
+)
+
+// ErrCircuitOpen matches CircuitOpenError with errors.Is
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitOpenError is returned without sending the request while the endpoint group circuit is open
+type CircuitOpenError struct {
+	Group EndpointGroup
+	// RetryAfter is a time left until probe requests are let through
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface
+func (e *CircuitOpenError) Error() string {
+	return "circuit breaker is open for " + string(e.Group) + ", retry after " + e.RetryAfter.String()
+}
+
+// Is reports whether the target is ErrCircuitOpen
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitStateChange describes a transition of an endpoint group circuit
+type CircuitStateChange struct {
+	Group EndpointGroup `json:"group"`
+	From  CircuitState  `json:"from"`
+	To    CircuitState  `json:"to"`
+	Time  time.Time     `json:"time"`
+}
+
+// CircuitMetrics is implemented by Config.Metrics to record circuit state changes
+type CircuitMetrics interface {
+	RecordCircuitState(ctx context.Context, change CircuitStateChange)
+}
+
+// CircuitBreakerSettings configures CircuitBreaker. Zero values are replaced with defaults
+type CircuitBreakerSettings struct {
+	// ConsecutiveFailures trips the circuit after the number of failures in a row. Default is 5
+	ConsecutiveFailures int
+	// FailureRate trips the circuit when the share of failures in the window reaches it. Zero disables it
+	FailureRate float64
+	// MinRequests is a minimum number of requests in the window to check the failure rate. Default is 20
+	MinRequests int
+	// Window is a period the failure rate is counted for. Default is 1 minute
+	Window time.Duration
+	// OpenTimeout is a time the circuit stays open before probe requests are let through. Default is 30 seconds
+	OpenTimeout time.Duration
+	// HalfOpenRequests is a number of probe requests which must succeed to close the circuit. Default is 1
+	HalfOpenRequests int
+	// OnStateChange is called on every state transition
+	OnStateChange func(CircuitStateChange)
+}
+
+// CircuitBreaker fails requests fast while an endpoint group keeps failing.
+// Network errors, timeouts and 5xx responses count as failures
+type CircuitBreaker struct {
+	settings CircuitBreakerSettings
+
+	mu       sync.Mutex
+	circuits map[EndpointGroup]*circuit
+}
+
+type circuit struct {
+	// generation is incremented on every transition, so outcomes of requests allowed before it are ignored
+	generation  uint64
+	state       CircuitState
+	consecutive int
+	requests    int
+	failures    int
+	windowStart time.Time
+	openedAt    time.Time
+	probes      int
+	successes   int
+}
+
+// NewCircuitBreaker returns a circuit breaker with closed circuits
+func NewCircuitBreaker(s CircuitBreakerSettings) *CircuitBreaker {
+	if s.ConsecutiveFailures <= 0 {
+		s.ConsecutiveFailures = defaultBreakerFailures
+	}
+	if s.MinRequests <= 0 {
+		s.MinRequests = defaultBreakerMinRequests
+	}
+	if s.Window <= 0 {
+		s.Window = defaultBreakerWindow
+	}
+	if s.OpenTimeout <= 0 {
+		s.OpenTimeout = defaultBreakerOpenTimeout
+	}
+	if s.HalfOpenRequests <= 0 {
+		s.HalfOpenRequests = defaultBreakerProbes
+	}
+
+	return &CircuitBreaker{settings: s, circuits: make(map[EndpointGroup]*circuit)}
+}
+
+// State returns the state of the endpoint group circuit
+func (b *CircuitBreaker) State(group EndpointGroup) CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.circuits[group]
+	if !ok {
+		return CircuitClosed
+	}
+	if c.state == CircuitOpen && time.Since(c.openedAt) >= b.settings.OpenTimeout {
+		return CircuitHalfOpen
+	}
+
+	return c.state
+}
+
+// circuitToken identifies an allowed request when its outcome is recorded
+type circuitToken struct {
+	generation uint64
+	// probe is set for requests allowed in the half-open state
+	probe bool
+}
+
+// allow reports whether the request may be sent and returns the token to pass to done.
+// The returned changes must be published by the caller
+func (b *CircuitBreaker) allow(group EndpointGroup) (circuitToken, []CircuitStateChange, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := b.circuit(group)
+	var changes []CircuitStateChange
+	if c.state == CircuitOpen {
+		wait := b.settings.OpenTimeout - time.Since(c.openedAt)
+		if wait > 0 {
+			return circuitToken{}, nil, &CircuitOpenError{Group: group, RetryAfter: wait}
+		}
+		changes = append(changes, b.transition(group, c, CircuitHalfOpen))
+	}
+
+	token := circuitToken{generation: c.generation}
+	if c.state == CircuitHalfOpen {
+		if c.probes >= b.settings.HalfOpenRequests {
+			return circuitToken{}, changes, &CircuitOpenError{Group: group}
+		}
+		c.probes++
+		token.probe = true
+	}
+
+	return token, changes, nil
+}
+
+// done records the outcome of the request allowed with the token and returns the state changes it caused.
+// Outcomes of requests allowed before the last transition are ignored
+func (b *CircuitBreaker) done(group EndpointGroup, token circuitToken, result circuitResult) []CircuitStateChange {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := b.circuit(group)
+	if token.generation != c.generation {
+		return nil
+	}
+	if result == circuitIgnored {
+		if token.probe && c.probes > 0 {
+			c.probes--
+		}
+		return nil
+	}
+
+	failed := result == circuitFailure
+	switch c.state {
+	case CircuitHalfOpen:
+		if failed {
+			return []CircuitStateChange{b.transition(group, c, CircuitOpen)}
+		}
+		c.successes++
+		if c.successes >= b.settings.HalfOpenRequests {
+			return []CircuitStateChange{b.transition(group, c, CircuitClosed)}
+		}
+		return nil
+	case CircuitOpen:
+		return nil
+	}
+
+	now := time.Now()
+	if now.Sub(c.windowStart) >= b.settings.Window {
+		c.windowStart, c.requests, c.failures = now, 0, 0
+	}
+	c.requests++
+	if !failed {
+		c.consecutive = 0
+		return nil
+	}
+	c.failures++
+	c.consecutive++
+
+	rateTripped := b.settings.FailureRate > 0 && c.requests >= b.settings.MinRequests &&
+		float64(c.failures)/float64(c.requests) >= b.settings.FailureRate
+	if c.consecutive >= b.settings.ConsecutiveFailures || rateTripped {
+		return []CircuitStateChange{b.transition(group, c, CircuitOpen)}
+	}
+
+	return nil
+}
+
+func (b *CircuitBreaker) circuit(group EndpointGroup) *circuit {
+	c, ok := b.circuits[group]
+	if !ok {
+		c = &circuit{state: CircuitClosed, windowStart: time.Now()}
+		b.circuits[group] = c
+	}
+
+	return c
+}
+
+// transition moves the circuit to the state and resets its counters. The caller must hold the lock
+func (b *CircuitBreaker) transition(group EndpointGroup, c *circuit, to CircuitState) CircuitStateChange {
+	change := CircuitStateChange{Group: group, From: c.state, To: to, Time: time.Now()}
+
+	*c = circuit{generation: c.generation + 1, state: to, windowStart: change.Time}
+	if to == CircuitOpen {
+		c.openedAt = change.Time
+	}
+
+	return change
+}
+
+// publishCircuitChanges calls the state change callback and records the changes to metrics outside the lock
+func (c client) publishCircuitChanges(ctx context.Context, changes []CircuitStateChange) {
+	for _, change := range changes {
+		if c.cfg.CircuitBreaker.settings.OnStateChange != nil {
+			c.cfg.CircuitBreaker.settings.OnStateChange(change)
+		}
+		if m, ok := c.cfg.Metrics.(CircuitMetrics); ok {
+			m.RecordCircuitState(ctx, change)
+		}
+	}
+}
+
+// circuitBody records the circuit outcome once the body is read to the end, fails or is closed,
+// so a backend stalling or resetting mid-body counts as failing
+type circuitBody struct {
+	io.ReadCloser
+	// errorClass is the class of the response status until a read fails
+	errorClass string
+	record     func(errorClass string)
+	once       sync.Once
+}
+
+func (b *circuitBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	switch {
+	case err == io.EOF:
+		b.done()
+	case err != nil:
+		b.errorClass = requestErrorClass(err)
+		b.done()
+	}
+
+	return n, err
+}
+
+func (b *circuitBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.done()
+
+	return err
+}
+
+func (b *circuitBody) done() {
+	b.once.Do(func() {
+		b.record(b.errorClass)
+	})
+}
+
+// circuitOutcome returns the circuit result of a request with the error class
+func circuitOutcome(ctx context.Context, errorClass string) circuitResult {
+	switch {
+	case errorClass == ErrorClassCanceled, ctx.Err() != nil && errorClass != "":
+		return circuitIgnored
+	case errorClass == ErrorClassNetwork || errorClass == ErrorClassTimeout || errorClass == ErrorClassServer:
+		return circuitFailure
+	}
+
+	return circuitSuccess
+}
+
+// endpointGroup returns the endpoint group of the URI
+func endpointGroup(uri string) EndpointGroup {
+	switch {
+	case strings.HasPrefix(uri, "/culture/"):
+		return EndpointGroupCulture
+	case strings.HasPrefix(uri, "/autosuggest/"):
+		return EndpointGroupAutoSuggest
+	}
+
+	return EndpointGroupLivePricing
+}
@@ -0,0 +1,253 @@
+package skyscanner
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+const testGroup = EndpointGroupCulture
+
+func newTestBreaker(changes *[]CircuitStateChange) *CircuitBreaker {
+	return NewCircuitBreaker(CircuitBreakerSettings{
+		ConsecutiveFailures: 2,
+		OpenTimeout:         time.Hour,
+		HalfOpenRequests:    2,
+		OnStateChange: func(c CircuitStateChange) {
+			*changes = append(*changes, c)
+		},
+	})
+}
+
+// request allows a request and records its outcome, publishing the changes like the client does
+func request(t *testing.T, b *CircuitBreaker, result circuitResult) error {
+	t.Helper()
+
+	token, changes, err := b.allow(testGroup)
+	publish(b, changes)
+	if err != nil {
+		return err
+	}
+	publish(b, b.done(testGroup, token, result))
+
+	return nil
+}
+
+func publish(b *CircuitBreaker, changes []CircuitStateChange) {
+	for _, c := range changes {
+		b.settings.OnStateChange(c)
+	}
+}
+
+// expireOpen makes the open circuit ready for probes without waiting for the open timeout
+func expireOpen(b *CircuitBreaker) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.circuits[testGroup].openedAt = time.Now().Add(-b.settings.OpenTimeout)
+}
+
+func checkState(t *testing.T, b *CircuitBreaker, want CircuitState) {
+	t.Helper()
+
+	if got := b.State(testGroup); got != want {
+		t.Fatalf("state is %s, want %s", got, want)
+	}
+}
+
+func openBreaker(t *testing.T, b *CircuitBreaker) {
+	t.Helper()
+
+	for i := 0; i < 2; i++ {
+		if err := request(t, b, circuitFailure); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkState(t, b, CircuitOpen)
+
+	if err := request(t, b, circuitSuccess); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("request to the open circuit returned %v", err)
+	}
+}
+
+func TestCircuitBreakerRecovers(t *testing.T) {
+	var changes []CircuitStateChange
+	b := newTestBreaker(&changes)
+
+	if err := request(t, b, circuitFailure); err != nil {
+		t.Fatal(err)
+	}
+	if err := request(t, b, circuitSuccess); err != nil {
+		t.Fatal(err)
+	}
+	checkState(t, b, CircuitClosed)
+
+	openBreaker(t, b)
+	expireOpen(b)
+	checkState(t, b, CircuitHalfOpen)
+
+	for i := 0; i < 2; i++ {
+		if err := request(t, b, circuitSuccess); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkState(t, b, CircuitClosed)
+
+	want := []CircuitState{CircuitOpen, CircuitHalfOpen, CircuitClosed}
+	if len(changes) != len(want) {
+		t.Fatalf("got %d changes, want %d: %+v", len(changes), len(want), changes)
+	}
+	for i, c := range changes {
+		if c.To != want[i] || c.Group != testGroup {
+			t.Errorf("change %d is %+v, want to %s", i, c, want[i])
+		}
+	}
+}
+
+func TestCircuitBreakerProbeFailureReopens(t *testing.T) {
+	var changes []CircuitStateChange
+	b := newTestBreaker(&changes)
+
+	openBreaker(t, b)
+	expireOpen(b)
+	if err := request(t, b, circuitFailure); err != nil {
+		t.Fatal(err)
+	}
+	checkState(t, b, CircuitOpen)
+
+	if len(changes) != 3 || changes[2].From != CircuitHalfOpen || changes[2].To != CircuitOpen {
+		t.Errorf("unexpected changes %+v", changes)
+	}
+}
+
+func TestCircuitBreakerLimitsProbes(t *testing.T) {
+	var changes []CircuitStateChange
+	b := newTestBreaker(&changes)
+
+	openBreaker(t, b)
+	expireOpen(b)
+
+	var tokens []circuitToken
+	for i := 0; i < 2; i++ {
+		token, probeChanges, err := b.allow(testGroup)
+		publish(b, probeChanges)
+		if err != nil || !token.probe {
+			t.Fatalf("probe %d: token %+v, error %v", i, token, err)
+		}
+		tokens = append(tokens, token)
+	}
+	if _, _, err := b.allow(testGroup); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("request over the probe limit returned %v", err)
+	}
+
+	// an ignored probe frees its slot
+	b.done(testGroup, tokens[0], circuitIgnored)
+	token, _, err := b.allow(testGroup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publish(b, b.done(testGroup, tokens[1], circuitSuccess))
+	publish(b, b.done(testGroup, token, circuitSuccess))
+	checkState(t, b, CircuitClosed)
+}
+
+func TestCircuitBreakerIgnoresStaleOutcomes(t *testing.T) {
+	var changes []CircuitStateChange
+	b := newTestBreaker(&changes)
+
+	// a slow request allowed while the circuit was closed
+	stale, _, err := b.allow(testGroup)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	openBreaker(t, b)
+	expireOpen(b)
+	probe, changesToHalfOpen, err := b.allow(testGroup)
+	publish(b, changesToHalfOpen)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the stale failure neither reopens the half-open circuit nor frees a probe slot
+	publish(b, b.done(testGroup, stale, circuitFailure))
+	publish(b, b.done(testGroup, stale, circuitIgnored))
+	checkState(t, b, CircuitHalfOpen)
+	b.mu.Lock()
+	probes := b.circuits[testGroup].probes
+	b.mu.Unlock()
+	if probes != 1 {
+		t.Fatalf("half-open circuit has %d probes, want 1", probes)
+	}
+
+	publish(b, b.done(testGroup, probe, circuitSuccess))
+	if err := request(t, b, circuitSuccess); err != nil {
+		t.Fatal(err)
+	}
+	checkState(t, b, CircuitClosed)
+
+	// the probe of the previous half-open generation does not affect the closed circuit
+	publish(b, b.done(testGroup, probe, circuitFailure))
+	publish(b, b.done(testGroup, probe, circuitFailure))
+	checkState(t, b, CircuitClosed)
+}
+
+// serverInterceptor sends requests to the test server instead of the API
+func serverInterceptor(serverURL string) Interceptor {
+	u, _ := url.Parse(serverURL)
+
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			req.URL.Scheme, req.URL.Host = u.Scheme, u.Host
+			return next.Do(req)
+		})
+	}
+}
+
+func TestCircuitBreakerCountsBodyReadFailures(t *testing.T) {
+	tests := []struct {
+		name  string
+		stall bool
+		want  CircuitState
+	}{
+		{name: "complete body", want: CircuitClosed},
+		{name: "stalled body", stall: true, want: CircuitOpen},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			release := make(chan struct{})
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if !tt.stall {
+					_, _ = w.Write([]byte(`{"status":"RESULT_STATUS_COMPLETE","locales":[]}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"status":"RESULT_STATUS_COMPLETE","loc`))
+				w.(http.Flusher).Flush()
+				<-release
+			}))
+			defer srv.Close()
+			defer close(release)
+
+			b := NewCircuitBreaker(CircuitBreakerSettings{ConsecutiveFailures: 1, OpenTimeout: time.Hour})
+			c := NewClient(&Config{
+				APIKey:         "key",
+				Timeouts:       EndpointTimeouts{Culture: Timeouts{BodyRead: 50 * time.Millisecond}},
+				CircuitBreaker: b,
+				Interceptors:   []Interceptor{serverInterceptor(srv.URL)},
+			})
+
+			_, errResp := c.Locales(context.Background())
+			if tt.stall != (errResp != nil) {
+				t.Fatalf("unexpected error %v", errResp)
+			}
+			if got := b.State(EndpointGroupCulture); got != tt.want {
+				t.Errorf("state is %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
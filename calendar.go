@@ -0,0 +1,189 @@
+package skyscanner
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultSearchWorkers = 4
+	maxCalendarDays      = 366
+)
+
+// FlexibleSearchRequest contains flexible date search attributes
+type FlexibleSearchRequest struct {
+	// Query is a template for every search. Its QueryLegs are ignored
+	Query       CreateRequestQuery
+	Origin      PlaceID
+	Destination PlaceID
+	// From and To are the first and the last departure dates, inclusive. Time fields are ignored
+	From LocalDatetime
+	To   LocalDatetime
+	// StayLengths are positive numbers of days between the departure and the return.
+	// Searches are one way if empty
+	StayLengths []int
+	// Workers is a positive number of concurrent searches. Default is 4
+	Workers int
+}
+
+// PriceCalendar is a grid of the cheapest prices per departure date and stay length
+type PriceCalendar struct {
+	Dates       []LocalDatetime `json:"dates"`
+	StayLengths []int           `json:"stayLengths,omitempty"`
+	// Cells are indexed by the departure date index and the stay length index.
+	// One way calendars have a single column
+	Cells [][]CalendarCell `json:"cells"`
+}
+
+// CalendarCell contains the cheapest itinerary found for a date or a date pair
+type CalendarCell struct {
+	Departure LocalDatetime  `json:"departure"`
+	Return    *LocalDatetime `json:"return,omitempty"`
+	// SessionToken of the search the itinerary belongs to
+	SessionToken  string         `json:"sessionToken,omitempty"`
+	ItineraryID   string         `json:"itineraryId,omitempty"`
+	PricingOption *PricingOption `json:"pricingOption,omitempty"`
+	// Price of the pricing option in major currency units
+	Price float64 `json:"price"`
+	// Error is set if the search failed or no priced itineraries were found
+	Error *ErrorResponse `json:"error,omitempty"`
+}
+
+// FlexibleSearch runs a live search for every departure date in the range
+// and every stay length concurrently and returns the cheapest price for each of them.
+// The client's rate limiter applies to all the searches.
+// Failed searches are reported in the cells.
+// Indicative prices are not supported since the SDK has no indicative search API, every cell is a live search
+func FlexibleSearch(ctx context.Context, c Client, req *FlexibleSearchRequest) (*PriceCalendar, *ErrorResponse) {
+	from := dateOnly(req.From.Time())
+	to := dateOnly(req.To.Time())
+	if to.Before(from) {
+		return nil, internalErrorResponse("invalid date range: the last date is before the first one")
+	}
+
+	days := int(to.Sub(from)/(24*time.Hour)) + 1
+	if days > maxCalendarDays {
+		return nil, internalErrorResponse("invalid date range: more than " + strconv.Itoa(maxCalendarDays) + " days")
+	}
+
+	for _, stay := range req.StayLengths {
+		if stay <= 0 {
+			return nil, internalErrorResponse("invalid stay length " + strconv.Itoa(stay) + ": the return must be after the departure")
+		}
+	}
+	if req.Workers < 0 {
+		return nil, internalErrorResponse("invalid number of workers " + strconv.Itoa(req.Workers))
+	}
+
+	columns := len(req.StayLengths)
+	if columns == 0 {
+		columns = 1
+	}
+
+	cal := &PriceCalendar{
+		Dates:       make([]LocalDatetime, days),
+		StayLengths: req.StayLengths,
+		Cells:       make([][]CalendarCell, days),
+	}
+	for d := range cal.Cells {
+		departure := from.AddDate(0, 0, d)
+		cal.Dates[d] = NewLocalDatetime(departure)
+		cal.Cells[d] = make([]CalendarCell, columns)
+		for s := range cal.Cells[d] {
+			cell := &cal.Cells[d][s]
+			cell.Departure = cal.Dates[d]
+			if len(req.StayLengths) > 0 {
+				ret := NewLocalDatetime(departure.AddDate(0, 0, req.StayLengths[s]))
+				cell.Return = &ret
+			}
+		}
+	}
+
+	workers := req.Workers
+	if workers == 0 {
+		workers = defaultSearchWorkers
+	}
+
+	started := make([]bool, days*columns)
+	runWorkers(ctx, workers, days*columns, func(i int) {
+		started[i] = true
+		cell := &cal.Cells[i/columns][i%columns]
+		cell.search(ctx, c, req)
+	})
+
+	for i, ok := range started {
+		if !ok {
+			cal.Cells[i/columns][i%columns].Error = internalErrorResponse("search was not started: " + ctx.Err().Error())
+		}
+	}
+
+	return cal, nil
+}
+
+// Cheapest returns the cheapest cell of the calendar or nil if nothing was found
+func (c *PriceCalendar) Cheapest() *CalendarCell {
+	var cheapest *CalendarCell
+	for d := range c.Cells {
+		for s := range c.Cells[d] {
+			cell := &c.Cells[d][s]
+			if cell.PricingOption == nil {
+				continue
+			}
+			if cheapest == nil || cell.Price < cheapest.Price {
+				cheapest = cell
+			}
+		}
+	}
+
+	return cheapest
+}
+
+func (cell *CalendarCell) search(ctx context.Context, c Client, req *FlexibleSearchRequest) {
+	query := req.Query
+	query.QueryLegs = []*QueryLeg{newQueryLeg(req.Origin, req.Destination, cell.Departure)}
+	if cell.Return != nil {
+		query.QueryLegs = append(query.QueryLegs, newQueryLeg(req.Destination, req.Origin, *cell.Return))
+	}
+
+	resp, errResp := Search(ctx, c, &CreateRequest{Query: &query})
+	if errResp != nil {
+		cell.Error = errResp
+		return
+	}
+
+	cell.SessionToken = resp.SessionToken
+	if resp.Content == nil || resp.Content.Results == nil {
+		cell.Error = internalErrorResponse("no itineraries found")
+		return
+	}
+
+	for id, it := range resp.Content.Results.Itineraries {
+		po, price := it.CheapestPricingOption()
+		if po == nil {
+			continue
+		}
+
+		if cell.PricingOption == nil || price < cell.Price || (price == cell.Price && id < cell.ItineraryID) {
+			cell.ItineraryID = id
+			cell.PricingOption = po
+			cell.Price = price
+		}
+	}
+
+	if cell.PricingOption == nil {
+		cell.Error = internalErrorResponse("no itineraries found")
+	}
+}
+
+func newQueryLeg(origin, destination PlaceID, date LocalDatetime) *QueryLeg {
+	return &QueryLeg{
+		OriginPlaceId:      &origin,
+		DestinationPlaceId: &destination,
+		Date:               &LocalDatetime{Year: date.Year, Month: date.Month, Day: date.Day},
+	}
+}
+
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
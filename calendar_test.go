@@ -0,0 +1,79 @@
+package skyscanner
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestFlexibleSearchValidation(t *testing.T) {
+	from := LocalDatetime{Year: 2024, Month: 11, Day: 3}
+	to := LocalDatetime{Year: 2024, Month: 11, Day: 5}
+
+	tests := []struct {
+		name    string
+		req     FlexibleSearchRequest
+		wantErr string
+	}{
+		{
+			name:    "reversed range",
+			req:     FlexibleSearchRequest{From: to, To: from},
+			wantErr: "invalid date range",
+		},
+		{
+			name:    "too long range",
+			req:     FlexibleSearchRequest{From: from, To: LocalDatetime{Year: 2026, Month: 1, Day: 1}},
+			wantErr: "invalid date range",
+		},
+		{
+			name:    "zero stay",
+			req:     FlexibleSearchRequest{From: from, To: to, StayLengths: []int{3, 0}},
+			wantErr: "invalid stay length 0",
+		},
+		{
+			name:    "negative stay",
+			req:     FlexibleSearchRequest{From: from, To: to, StayLengths: []int{-2}},
+			wantErr: "invalid stay length -2",
+		},
+		{
+			name:    "negative workers",
+			req:     FlexibleSearchRequest{From: from, To: to, Workers: -1},
+			wantErr: "invalid number of workers -1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cal, errResp := FlexibleSearch(context.Background(), nil, &tt.req)
+			if errResp == nil || !strings.Contains(errResp.Message, tt.wantErr) {
+				t.Fatalf("got calendar %v and error %v, want error %q", cal, errResp, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFlexibleSearchGrid(t *testing.T) {
+	req := &FlexibleSearchRequest{
+		From:        LocalDatetime{Year: 2024, Month: 12, Day: 30},
+		To:          LocalDatetime{Year: 2025, Month: 1, Day: 1},
+		StayLengths: []int{1, 7},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	cal, errResp := FlexibleSearch(ctx, &stubClient{}, req)
+	if errResp != nil {
+		t.Fatal(errResp)
+	}
+
+	if len(cal.Dates) != 3 || len(cal.Cells) != 3 || len(cal.Cells[0]) != 2 {
+		t.Fatalf("unexpected calendar shape %d dates, %d rows", len(cal.Dates), len(cal.Cells))
+	}
+	last := cal.Cells[2][1]
+	if last.Departure != (LocalDatetime{Year: 2025, Month: 1, Day: 1}) || *last.Return != (LocalDatetime{Year: 2025, Month: 1, Day: 8}) {
+		t.Errorf("unexpected dates of the last cell %+v - %+v", last.Departure, *last.Return)
+	}
+	if last.Error == nil || last.PricingOption != nil {
+		t.Errorf("canceled search has no error: %+v", last)
+	}
+}
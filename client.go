@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"strings"
@@ -16,18 +17,42 @@ const (
 )
 
 type client struct {
-	cfg *Config
+	cfg        *Config
+	doer       Doer
+	httpClient *http.Client
+	// timeouts and logOptions are the config values with defaults
+	timeouts   EndpointTimeouts
+	logOptions LogOptions
 }
 
-// NewClient returns new SkyScanner client instance
+// NewClient returns new SkyScanner client instance.
+// Defaults are resolved into the client, the config is not modified
 func NewClient(cfg *Config) Client {
-	if cfg.QueriesTimeout == 0 {
-		cfg.QueriesTimeout = time.Second * 15
+	queriesTimeout := cfg.QueriesTimeout
+	if queriesTimeout == 0 {
+		queriesTimeout = time.Second * 15
 	}
 
-	return &client{
-		cfg: cfg,
+	// the chain is built from the config without storing it back,
+	// so a copied config with another API key does not reuse the old key interceptor
+	interceptors := cfg.Interceptors
+	if interceptors == nil {
+		apiKey := cfg.APIKey
+		if cfg.KeyProvider != nil {
+			apiKey = ""
+		}
+		interceptors = DefaultInterceptors(apiKey)
 	}
+
+	c := &client{
+		cfg:        cfg,
+		timeouts:   cfg.Timeouts.withDefaults(queriesTimeout),
+		logOptions: cfg.LogOptions.withLogDefaults(),
+	}
+	c.httpClient = newHTTPClient()
+	c.doer = c.chain(interceptors)
+
+	return c
 }
 
 // Create does a create request
@@ -39,7 +64,7 @@ func (c client) Create(ctx context.Context, req *CreateRequest) (*CreatePollResp
 
 	r, err := c.do(ctx, http.MethodPost, "/flights/live/search/create", jsonData)
 	if err != nil {
-		return nil, internalErrorResponse("request doing error: " + err.Error())
+		return nil, requestErrorResponse(err)
 	}
 	defer func() {
 		_ = r.Body.Close()
@@ -51,7 +76,7 @@ func (c client) Create(ctx context.Context, req *CreateRequest) (*CreatePollResp
 
 	var resp CreatePollResponse
 	if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
-		return nil, internalErrorResponse("response decoding error: " + err.Error())
+		return nil, responseErrorResponse(err)
 	}
 
 	return &resp, nil
@@ -62,7 +87,7 @@ func (c client) Poll(ctx context.Context, req *PollRequest) (*CreatePollResponse
 	uri := "/flights/live/search/poll/" + req.SessionToken
 	r, err := c.do(ctx, http.MethodPost, uri, []byte{})
 	if err != nil {
-		return nil, internalErrorResponse("request doing error: " + err.Error())
+		return nil, requestErrorResponse(err)
 	}
 	defer func() {
 		_ = r.Body.Close()
@@ -74,7 +99,7 @@ func (c client) Poll(ctx context.Context, req *PollRequest) (*CreatePollResponse
 
 	var resp CreatePollResponse
 	if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
-		return nil, internalErrorResponse("response decoding error: " + err.Error())
+		return nil, responseErrorResponse(err)
 	}
 
 	return &resp, nil
@@ -84,7 +109,7 @@ func (c client) Poll(ctx context.Context, req *PollRequest) (*CreatePollResponse
 func (c client) Locales(ctx context.Context) (*LocalesResponse, *ErrorResponse) {
 	r, err := c.do(ctx, http.MethodGet, "/culture/locales", []byte{})
 	if err != nil {
-		return nil, internalErrorResponse("request doing error: " + err.Error())
+		return nil, requestErrorResponse(err)
 	}
 	defer func() {
 		_ = r.Body.Close()
@@ -96,7 +121,7 @@ func (c client) Locales(ctx context.Context) (*LocalesResponse, *ErrorResponse)
 
 	var resp LocalesResponse
 	if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
-		return nil, internalErrorResponse("response decoding error: " + err.Error())
+		return nil, responseErrorResponse(err)
 	}
 
 	return &resp, nil
@@ -106,7 +131,7 @@ func (c client) Locales(ctx context.Context) (*LocalesResponse, *ErrorResponse)
 func (c client) Currencies(ctx context.Context) (*CurrenciesResponse, *ErrorResponse) {
 	r, err := c.do(ctx, http.MethodGet, "/culture/currencies", []byte{})
 	if err != nil {
-		return nil, internalErrorResponse("request doing error: " + err.Error())
+		return nil, requestErrorResponse(err)
 	}
 	defer func() {
 		_ = r.Body.Close()
@@ -118,7 +143,7 @@ func (c client) Currencies(ctx context.Context) (*CurrenciesResponse, *ErrorResp
 
 	var resp CurrenciesResponse
 	if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
-		return nil, internalErrorResponse("response decoding error: " + err.Error())
+		return nil, responseErrorResponse(err)
 	}
 
 	return &resp, nil
@@ -129,7 +154,7 @@ func (c client) Markets(ctx context.Context, locale string) (*MarketsResponse, *
 	uri := "/culture/markets/" + locale
 	r, err := c.do(ctx, http.MethodGet, uri, []byte{})
 	if err != nil {
-		return nil, internalErrorResponse("request doing error: " + err.Error())
+		return nil, requestErrorResponse(err)
 	}
 	defer func() {
 		_ = r.Body.Close()
@@ -141,7 +166,7 @@ func (c client) Markets(ctx context.Context, locale string) (*MarketsResponse, *
 
 	var resp MarketsResponse
 	if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
-		return nil, internalErrorResponse("response decoding error: " + err.Error())
+		return nil, responseErrorResponse(err)
 	}
 
 	return &resp, nil
@@ -152,7 +177,7 @@ func (c client) NearestCulture(ctx context.Context, ip string) (*NearestCultureR
 	uri := "/culture/nearestculture?ipAddress=" + ip
 	r, err := c.do(ctx, http.MethodGet, uri, []byte{})
 	if err != nil {
-		return nil, internalErrorResponse("request doing error: " + err.Error())
+		return nil, requestErrorResponse(err)
 	}
 	defer func() {
 		_ = r.Body.Close()
@@ -164,7 +189,7 @@ func (c client) NearestCulture(ctx context.Context, ip string) (*NearestCultureR
 
 	var resp NearestCultureResponse
 	if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
-		return nil, internalErrorResponse("response decoding error: " + err.Error())
+		return nil, responseErrorResponse(err)
 	}
 
 	return &resp, nil
@@ -179,7 +204,7 @@ func (c client) AutoSuggestFlights(ctx context.Context, req *AutoSuggestFlightsR
 
 	r, err := c.do(ctx, http.MethodPost, "/autosuggest/flights", jsonData)
 	if err != nil {
-		return nil, internalErrorResponse("request doing error: " + err.Error())
+		return nil, requestErrorResponse(err)
 	}
 	defer func() {
 		_ = r.Body.Close()
@@ -191,27 +216,94 @@ func (c client) AutoSuggestFlights(ctx context.Context, req *AutoSuggestFlightsR
 
 	var resp AutoSuggestFlightsResponse
 	if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
-		return nil, internalErrorResponse("response decoding error: " + err.Error())
+		return nil, responseErrorResponse(err)
 	}
 
 	return &resp, nil
 }
 
+// SearchSettings implements SearchConfigurer with the Config values
+func (c client) SearchSettings() SearchSettings {
+	return SearchSettings{
+		PollInterval: c.cfg.PollInterval,
+		SearchHook:   c.cfg.SearchHook,
+		Tracer:       c.cfg.Tracer,
+		Metrics:      c.cfg.Metrics,
+	}
+}
+
 func (c client) do(ctx context.Context, method, uri string, body []byte) (*http.Response, error) {
-	req, err := http.NewRequestWithContext(ctx, method, c.getURL(uri), bytes.NewBuffer(body))
-	if err != nil {
+	path := pathTemplate(uri)
+	ctx, span := c.tracer().Start(ctx, method+" "+path,
+		Attribute{"http.request.method", method},
+		Attribute{"url.path", path},
+	)
+	metric := RequestMetric{Method: method, Path: path}
+	start := time.Now()
+	endpoint := endpointOf(uri)
+	var timeouts *requestTimeouts
+
+	recordCircuit := func(string) {}
+
+	fail := func(err error) (*http.Response, error) {
+		reqCtx := ctx
+		if timeouts != nil {
+			reqCtx = timeouts.ctx
+			defer timeouts.release()
+		}
+		if tErr := timeoutError(ctx, reqCtx, endpoint, err); tErr != nil {
+			err = tErr
+		}
+		metric.Duration = time.Since(start)
+		metric.ErrorClass = requestErrorClass(err)
+		recordCircuit(metric.ErrorClass)
+		c.logError(ctx, method, uri, err, start)
+		span.RecordError(err)
+		span.End()
+		c.metrics().RecordRequest(ctx, metric)
+
 		return nil, err
 	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set(AuthHeader, c.cfg.APIKey)
-	req.Close = true
 
-	httpClient := http.Client{Timeout: c.cfg.QueriesTimeout}
-	res, err := httpClient.Do(req)
+	if c.cfg.RateLimiter != nil {
+		if err := c.cfg.RateLimiter.Wait(ctx); err != nil {
+			return fail(err)
+		}
+	}
+
+	if b := c.cfg.CircuitBreaker; b != nil {
+		group := endpointGroup(uri)
+		token, changes, err := b.allow(group)
+		c.publishCircuitChanges(ctx, changes)
+		if err != nil {
+			return fail(err)
+		}
+
+		recordCircuit = func(errorClass string) {
+			c.publishCircuitChanges(ctx, b.done(group, token, circuitOutcome(ctx, errorClass)))
+		}
+	}
+
+	timeouts = newRequestTimeouts(ctx, endpoint, c.timeouts.get(endpoint))
+	req, err := http.NewRequestWithContext(timeouts.ctx, method, c.getURL(uri), bytes.NewBuffer(body))
 	if err != nil {
-		return nil, err
+		return fail(err)
 	}
 
+	res, err := c.doer.Do(req)
+	if err != nil {
+		return fail(err)
+	}
+
+	metric.StatusCode = res.StatusCode
+	metric.ErrorClass = statusErrorClass(res.StatusCode)
+	span.SetAttributes(Attribute{"http.response.status_code", res.StatusCode})
+	res.Body = &timeoutBody{ReadCloser: res.Body, callerCtx: ctx, timeouts: timeouts}
+	if c.cfg.CircuitBreaker != nil {
+		res.Body = &circuitBody{ReadCloser: res.Body, errorClass: metric.ErrorClass, record: recordCircuit}
+	}
+	res.Body = &instrumentedBody{ReadCloser: res.Body, ctx: ctx, span: span, start: start, metric: metric, m: c.metrics()}
+
 	return res, nil
 }
 
@@ -231,12 +323,41 @@ func internalErrorResponse(msg string) *ErrorResponse {
 	}
 }
 
+// requestErrorResponse returns the error response of a request which was not completed.
+// The error is kept, so it can be checked with errors.Is and errors.As
+func requestErrorResponse(err error) *ErrorResponse {
+	errResp := internalErrorResponse("request doing error: " + err.Error())
+	errResp.Err = err
+
+	var circuitErr *CircuitOpenError
+	switch {
+	case errors.As(err, &circuitErr):
+		errResp.Code = http.StatusServiceUnavailable
+	case errors.Is(err, ErrTimeout):
+		errResp.Code = http.StatusGatewayTimeout
+	}
+
+	return errResp
+}
+
+// responseErrorResponse returns the error response of a response body which could not be read or decoded
+func responseErrorResponse(err error) *ErrorResponse {
+	errResp := internalErrorResponse("response decoding error: " + err.Error())
+	errResp.Err = err
+	if errors.Is(err, ErrTimeout) {
+		errResp.Code = http.StatusGatewayTimeout
+	}
+
+	return errResp
+}
+
 func badResponseStatus(resp *http.Response) *ErrorResponse {
 	errResp := &ErrorResponse{}
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
 		errResp.Code = resp.StatusCode
 		errResp.Message = "response reading error:" + err.Error()
+		errResp.Err = err
 		return errResp
 	}
 
@@ -248,3 +369,16 @@ func badResponseStatus(resp *http.Response) *ErrorResponse {
 
 	return errResp
 }
+
+// mergePollResponse applies the poll response to the previous one.
+// The content is kept unless the poll replaced it
+func mergePollResponse(prev, poll *CreatePollResponse) *CreatePollResponse {
+	if poll.SessionToken == "" {
+		poll.SessionToken = prev.SessionToken
+	}
+	if poll.Action != ResponseActionReplaced || poll.Content == nil {
+		poll.Content = prev.Content
+	}
+
+	return poll
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// cache is an in-memory TTL cache of response bodies holding up to size entries
+type cache struct {
+	size int
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+func newCache(size int) *cache {
+	return &cache{size: size, entries: make(map[string]cacheEntry)}
+}
+
+// cacheKey joins the normalised key parts, so equal requests share an entry
+// regardless of the query parameters order and case
+func cacheKey(parts ...string) string {
+	for i, p := range parts {
+		parts[i] = strings.ToLower(strings.TrimSpace(p))
+	}
+
+	return strings.Join(parts, "\x00")
+}
+
+func (c *cache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(e.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	return e.body, true
+}
+
+// set stores the body. Expired entries are removed first and
+// the entry expiring soonest is evicted if the cache is still full
+func (c *cache) set(key string, body []byte, ttl time.Duration) {
+	if ttl <= 0 || c.size <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.size {
+		var oldest string
+		for k, e := range c.entries {
+			if oldest == "" || e.expires.Before(c.entries[oldest].expires) {
+				oldest = k
+			}
+		}
+		delete(c.entries, oldest)
+	}
+	c.entries[key] = cacheEntry{body: body, expires: now.Add(ttl)}
+}
@@ -0,0 +1,115 @@
+// Command skyscanner-gateway is an HTTP server exposing a simplified search API over the SDK,
+// so mobile and web clients do not need the partner API key.
+//
+// Configuration is read from the environment:
+//
+//	SKYSCANNER_API_KEY    partner API key (required)
+//	GATEWAY_ADDR          listen address, default :8080
+//	GATEWAY_CLIENT_KEYS   comma separated keys clients send in the X-Client-Key header (required)
+//	GATEWAY_CORS_ORIGINS  comma separated allowed origins, * allows any
+//	GATEWAY_CACHE_TTL     culture and autosuggest responses cache TTL, default 1h
+//	GATEWAY_CACHE_SIZE    max number of cached responses, default 1000
+//	GATEWAY_SEARCH_TTL    time to keep searches, default 30m
+//	GATEWAY_MAX_SEARCHES  max number of running searches per client key, default 5
+//
+// Endpoints:
+//
+//	POST /v1/searches                  start a search, returns its ID
+//	GET  /v1/searches/{id}             search status, stats and resolved itineraries,
+//	                                   only for the client key that started the search
+//	GET  /v1/autosuggest?term=         places matching the term
+//	GET  /v1/culture/locales           supported locales
+//	GET  /v1/culture/currencies        supported currencies
+//	GET  /v1/culture/markets?locale=   supported markets
+//	GET  /v1/culture/nearest?ip=       nearest culture, the caller IP is used by default
+package main
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VitaliyJ/skyscanner"
+)
+
+type config struct {
+	addr        string
+	apiKey      string
+	clientKeys  map[string]struct{}
+	corsOrigins map[string]struct{}
+	cacheTTL    time.Duration
+	cacheSize   int
+	searchTTL   time.Duration
+	maxSearches int
+}
+
+func main() {
+	cfg, err := loadConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c := skyscanner.NewClient(&skyscanner.Config{APIKey: cfg.apiKey})
+	srv := &http.Server{
+		Addr:              cfg.addr,
+		Handler:           newServer(c, cfg).routes(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	log.Println("listening on", cfg.addr)
+	log.Fatal(srv.ListenAndServe())
+}
+
+func loadConfig() (*config, error) {
+	cfg := &config{
+		addr:        envOr("GATEWAY_ADDR", ":8080"),
+		apiKey:      os.Getenv("SKYSCANNER_API_KEY"),
+		clientKeys:  splitSet(os.Getenv("GATEWAY_CLIENT_KEYS")),
+		corsOrigins: splitSet(os.Getenv("GATEWAY_CORS_ORIGINS")),
+	}
+	if cfg.apiKey == "" {
+		return nil, errors.New("SKYSCANNER_API_KEY is required")
+	}
+	if len(cfg.clientKeys) == 0 {
+		return nil, errors.New("GATEWAY_CLIENT_KEYS is required")
+	}
+
+	var err error
+	if cfg.cacheTTL, err = time.ParseDuration(envOr("GATEWAY_CACHE_TTL", "1h")); err != nil {
+		return nil, errors.New("invalid GATEWAY_CACHE_TTL: " + err.Error())
+	}
+	if cfg.cacheSize, err = strconv.Atoi(envOr("GATEWAY_CACHE_SIZE", "1000")); err != nil {
+		return nil, errors.New("invalid GATEWAY_CACHE_SIZE: " + err.Error())
+	}
+	if cfg.searchTTL, err = time.ParseDuration(envOr("GATEWAY_SEARCH_TTL", "30m")); err != nil {
+		return nil, errors.New("invalid GATEWAY_SEARCH_TTL: " + err.Error())
+	}
+	if cfg.maxSearches, err = strconv.Atoi(envOr("GATEWAY_MAX_SEARCHES", "5")); err != nil {
+		return nil, errors.New("invalid GATEWAY_MAX_SEARCHES: " + err.Error())
+	}
+
+	return cfg, nil
+}
+
+func envOr(name, def string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+
+	return def
+}
+
+func splitSet(s string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			set[v] = struct{}{}
+		}
+	}
+
+	return set
+}
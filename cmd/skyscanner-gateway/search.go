@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/VitaliyJ/skyscanner"
+)
+
+const (
+	searchStatusRunning  = "RUNNING"
+	searchStatusComplete = "COMPLETE"
+	searchStatusFailed   = "FAILED"
+
+	searchTimeout = 2 * time.Minute
+)
+
+// errTooManySearches is returned when a client key reaches the limit of running searches
+var errTooManySearches = errors.New("too many running searches")
+
+// searchRequest is the simplified search request body
+type searchRequest struct {
+	// Origin and Destination are IATA codes or entity IDs
+	Origin      string `json:"origin"`
+	Destination string `json:"destination"`
+	// Date and ReturnDate are in the 2006-01-02 format, ReturnDate is optional
+	Date         string                `json:"date"`
+	ReturnDate   string                `json:"returnDate,omitempty"`
+	Adults       int32                 `json:"adults"`
+	ChildrenAges []int                 `json:"childrenAges,omitempty"`
+	CabinClass   skyscanner.CabinClass `json:"cabinClass,omitempty"`
+	Market       string                `json:"market,omitempty"`
+	Locale       string                `json:"locale,omitempty"`
+	Currency     string                `json:"currency,omitempty"`
+}
+
+// searchView is the search response body. Session tokens are never exposed
+type searchView struct {
+	ID          string                          `json:"id"`
+	Status      string                          `json:"status"`
+	Error       string                          `json:"error,omitempty"`
+	Stats       *skyscanner.Stats               `json:"stats,omitempty"`
+	Total       int                             `json:"total"`
+	Itineraries []*skyscanner.ResolvedItinerary `json:"itineraries"`
+}
+
+type pageParams struct {
+	// sort is "best", "cheapest" or "fastest". Default is "best"
+	sort   string
+	offset int
+	limit  int
+}
+
+type search struct {
+	id string
+	// owner is the client key that created the search
+	owner   string
+	created time.Time
+
+	mu     sync.RWMutex
+	status string
+	err    string
+	resp   *skyscanner.CreatePollResponse
+}
+
+// searchStore runs searches in the background and keeps them for the TTL.
+// Every client key can run up to maxRunning searches at once
+type searchStore struct {
+	client     skyscanner.Client
+	ttl        time.Duration
+	maxRunning int
+
+	mu       sync.Mutex
+	searches map[string]*search
+	running  map[string]int
+}
+
+func (r searchRequest) query() (*skyscanner.CreateRequest, error) {
+	req, err := skyscanner.NewCreateRequest(r.Origin, r.Destination, r.Date, r.ReturnDate)
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.Query
+	q.Market = valueOr(r.Market, defaultMarket)
+	q.Locale = valueOr(r.Locale, defaultLocale)
+	q.Currency = valueOr(r.Currency, defaultCurrency)
+	q.CabinClass = r.CabinClass
+	q.ChildrenAges = r.ChildrenAges
+	if r.Adults != 0 {
+		q.Adults = r.Adults
+	}
+
+	return req, nil
+}
+
+func newSearchStore(c skyscanner.Client, ttl time.Duration, maxRunning int) *searchStore {
+	return &searchStore{
+		client:     c,
+		ttl:        ttl,
+		maxRunning: maxRunning,
+		searches:   make(map[string]*search),
+		running:    make(map[string]int),
+	}
+}
+
+// start registers a new search of the client key and runs it in the background
+func (s *searchStore) start(owner string, req *skyscanner.CreateRequest) (*search, error) {
+	srch := &search{id: newSearchID(), owner: owner, created: time.Now(), status: searchStatusRunning}
+
+	s.mu.Lock()
+	if s.running[owner] >= s.maxRunning {
+		s.mu.Unlock()
+		return nil, errTooManySearches
+	}
+	s.running[owner]++
+	s.evict()
+	s.searches[srch.id] = srch
+	s.mu.Unlock()
+
+	go func() {
+		defer s.release(owner)
+		srch.run(s.client, req)
+	}()
+
+	return srch, nil
+}
+
+func (s *searchStore) release(owner string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running[owner]--; s.running[owner] <= 0 {
+		delete(s.running, owner)
+	}
+}
+
+// get returns the search if it was created with the same client key
+func (s *searchStore) get(id, owner string) (*search, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.evict()
+	srch, ok := s.searches[id]
+	if !ok || srch.owner != owner {
+		return nil, false
+	}
+
+	return srch, true
+}
+
+// evict removes searches older than the TTL. The caller must hold the lock
+func (s *searchStore) evict() {
+	for id, srch := range s.searches {
+		if time.Since(srch.created) > s.ttl {
+			delete(s.searches, id)
+		}
+	}
+}
+
+// run searches with the SDK search loop, updating the search on every poll,
+// so clients see partial results while the search is running
+func (srch *search) run(c skyscanner.Client, req *skyscanner.CreateRequest) {
+	ctx, cancel := context.WithTimeout(context.Background(), searchTimeout)
+	defer cancel()
+
+	resp, errResp := skyscanner.SearchWithProgress(ctx, c, req, srch.update)
+	if errResp != nil {
+		msg := errResp.Message
+		if errors.Is(errResp, skyscanner.ErrTimeout) {
+			msg = "search timed out"
+		}
+		srch.finish(resp, msg)
+		return
+	}
+
+	srch.finish(resp, "")
+}
+
+func (srch *search) update(resp *skyscanner.CreatePollResponse) {
+	srch.mu.Lock()
+	defer srch.mu.Unlock()
+
+	srch.resp = resp
+}
+
+func (srch *search) finish(resp *skyscanner.CreatePollResponse, errMsg string) {
+	srch.mu.Lock()
+	defer srch.mu.Unlock()
+
+	if resp != nil {
+		srch.resp = resp
+	}
+	srch.err = errMsg
+	srch.status = searchStatusComplete
+	if errMsg != "" {
+		srch.status = searchStatusFailed
+	}
+}
+
+// view returns the search status with a page of sorted resolved itineraries.
+// Itineraries are omitted if page is nil
+func (srch *search) view(page *pageParams) *searchView {
+	srch.mu.RLock()
+	defer srch.mu.RUnlock()
+
+	v := &searchView{
+		ID:          srch.id,
+		Status:      srch.status,
+		Error:       srch.err,
+		Itineraries: make([]*skyscanner.ResolvedItinerary, 0),
+	}
+	if srch.resp == nil || srch.resp.Content == nil || srch.resp.Content.Results == nil {
+		return v
+	}
+
+	r := srch.resp.Content.Results
+	v.Stats = srch.resp.Content.Stats
+	if v.Stats == nil {
+		v.Stats = r.ComputeStats()
+	}
+	v.Total = len(r.Itineraries)
+	if page == nil {
+		return v
+	}
+
+	ids := sortedIDs(r, page.sort)
+	if page.offset < 0 || page.offset >= len(ids) {
+		return v
+	}
+	ids = ids[page.offset:]
+	if len(ids) > page.limit {
+		ids = ids[:page.limit]
+	}
+
+	for _, id := range ids {
+		if ri := r.ResolveItinerary(id); ri != nil {
+			v.Itineraries = append(v.Itineraries, ri)
+		}
+	}
+
+	return v
+}
+
+func sortedIDs(r *skyscanner.Results, order string) []string {
+	ids := make([]string, 0, len(r.Itineraries))
+	for id := range r.Itineraries {
+		ids = append(ids, id)
+	}
+
+	switch order {
+	case "cheapest":
+		r.SortItineraries(ids, skyscanner.SortCheapest)
+	case "fastest":
+		r.SortItineraries(ids, skyscanner.SortFastest)
+	default:
+		ranked := r.Rank(ids, skyscanner.DefaultWeightedScorer)
+		ids = ids[:0]
+		for _, item := range ranked {
+			ids = append(ids, item.ItineraryID)
+		}
+	}
+
+	return ids
+}
+
+func newSearchID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/VitaliyJ/skyscanner"
+)
+
+const (
+	clientKeyHeader = "X-Client-Key"
+	defaultMarket   = "UK"
+	defaultLocale   = "en-GB"
+	defaultCurrency = "GBP"
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+type server struct {
+	client   skyscanner.Client
+	cfg      *config
+	cache    *cache
+	searches *searchStore
+}
+
+type errorBody struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func newServer(c skyscanner.Client, cfg *config) *server {
+	return &server{
+		client:   c,
+		cfg:      cfg,
+		cache:    newCache(cfg.cacheSize),
+		searches: newSearchStore(c, cfg.searchTTL, cfg.maxSearches),
+	}
+}
+
+func (s *server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/searches", s.handleCreateSearch)
+	mux.HandleFunc("/v1/searches/", s.handleGetSearch)
+	mux.HandleFunc("/v1/autosuggest", s.handleAutoSuggest)
+	mux.HandleFunc("/v1/culture/locales", s.handleLocales)
+	mux.HandleFunc("/v1/culture/currencies", s.handleCurrencies)
+	mux.HandleFunc("/v1/culture/markets", s.handleMarkets)
+	mux.HandleFunc("/v1/culture/nearest", s.handleNearestCulture)
+
+	return s.cors(s.authenticate(mux))
+}
+
+func (s *server) cors(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin != "" && s.originAllowed(origin) {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, "+clientKeyHeader)
+			w.Header().Set("Access-Control-Max-Age", "600")
+			w.Header().Add("Vary", "Origin")
+		}
+
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *server) originAllowed(origin string) bool {
+	if _, ok := s.cfg.corsOrigins["*"]; ok {
+		return true
+	}
+	_, ok := s.cfg.corsOrigins[origin]
+
+	return ok
+}
+
+func (s *server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.cfg.clientKeys[r.Header.Get(clientKeyHeader)]; !ok {
+			writeError(w, http.StatusUnauthorized, "invalid client key")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *server) handleCreateSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req searchRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<16)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "request decoding error: "+err.Error())
+		return
+	}
+
+	query, err := req.query()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	srch, err := s.searches.start(r.Header.Get(clientKeyHeader), query)
+	if err != nil {
+		writeError(w, http.StatusTooManyRequests, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusAccepted, srch.view(nil))
+}
+
+func (s *server) handleGetSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	srch, ok := s.searches.get(strings.TrimPrefix(r.URL.Path, "/v1/searches/"), r.Header.Get(clientKeyHeader))
+	if !ok {
+		writeError(w, http.StatusNotFound, "search not found")
+		return
+	}
+
+	page := pageParams{
+		sort:   r.URL.Query().Get("sort"),
+		offset: intParam(r, "offset", 0),
+		limit:  intParam(r, "limit", defaultPageSize),
+	}
+	if page.limit <= 0 || page.limit > maxPageSize {
+		page.limit = maxPageSize
+	}
+
+	writeJSON(w, http.StatusOK, srch.view(&page))
+}
+
+func (s *server) handleAutoSuggest(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	req := &skyscanner.AutoSuggestFlightsRequest{
+		Query: skyscanner.AutoSuggestFlightsRequestQuery{
+			Locale:     valueOr(q.Get("locale"), defaultLocale),
+			Market:     valueOr(q.Get("market"), defaultMarket),
+			SearchTerm: strings.TrimSpace(q.Get("term")),
+		},
+		Limit:         int32(intParam(r, "limit", 10)),
+		IsDestination: q.Get("destination") == "true",
+	}
+
+	key := cacheKey("autosuggest", req.Query.Locale, req.Query.Market, req.Query.SearchTerm,
+		strconv.Itoa(int(req.Limit)), strconv.FormatBool(req.IsDestination))
+	s.cached(w, r, key, func(ctx context.Context) (interface{}, *skyscanner.ErrorResponse) {
+		return s.client.AutoSuggestFlights(ctx, req)
+	})
+}
+
+func (s *server) handleLocales(w http.ResponseWriter, r *http.Request) {
+	s.cached(w, r, "locales", func(ctx context.Context) (interface{}, *skyscanner.ErrorResponse) {
+		return s.client.Locales(ctx)
+	})
+}
+
+func (s *server) handleCurrencies(w http.ResponseWriter, r *http.Request) {
+	s.cached(w, r, "currencies", func(ctx context.Context) (interface{}, *skyscanner.ErrorResponse) {
+		return s.client.Currencies(ctx)
+	})
+}
+
+func (s *server) handleMarkets(w http.ResponseWriter, r *http.Request) {
+	locale := valueOr(r.URL.Query().Get("locale"), defaultLocale)
+	s.cached(w, r, cacheKey("markets", locale), func(ctx context.Context) (interface{}, *skyscanner.ErrorResponse) {
+		return s.client.Markets(ctx, locale)
+	})
+}
+
+func (s *server) handleNearestCulture(w http.ResponseWriter, r *http.Request) {
+	ip := r.URL.Query().Get("ip")
+	if ip == "" {
+		ip, _, _ = net.SplitHostPort(r.RemoteAddr)
+	}
+	if net.ParseIP(ip) == nil {
+		writeError(w, http.StatusBadRequest, "invalid ip")
+		return
+	}
+
+	s.cached(w, r, cacheKey("nearest", net.ParseIP(ip).String()), func(ctx context.Context) (interface{}, *skyscanner.ErrorResponse) {
+		return s.client.NearestCulture(ctx, ip)
+	})
+}
+
+// cached writes the cached response body or calls fn and caches its response
+func (s *server) cached(w http.ResponseWriter, r *http.Request, key string, fn func(ctx context.Context) (interface{}, *skyscanner.ErrorResponse)) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	if body, ok := s.cache.get(key); ok {
+		w.Header().Set("X-Cache", "HIT")
+		writeBody(w, http.StatusOK, body)
+		return
+	}
+
+	resp, errResp := fn(r.Context())
+	if errResp != nil {
+		writeUpstreamError(w, errResp)
+		return
+	}
+
+	body, err := json.Marshal(resp)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "response encoding error: "+err.Error())
+		return
+	}
+
+	s.cache.set(key, body, s.cfg.cacheTTL)
+	w.Header().Set("X-Cache", "MISS")
+	writeBody(w, http.StatusOK, body)
+}
+
+func writeUpstreamError(w http.ResponseWriter, errResp *skyscanner.ErrorResponse) {
+	code := errResp.Code
+	if code < 400 || code > 599 {
+		code = http.StatusBadGateway
+	}
+
+	writeError(w, code, errResp.Message)
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	writeJSON(w, code, errorBody{Code: code, Message: msg})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		code = http.StatusInternalServerError
+		body = []byte(`{"code":500,"message":"response encoding error"}`)
+	}
+
+	writeBody(w, code, body)
+}
+
+func writeBody(w http.ResponseWriter, code int, body []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(body)
+}
+
+func intParam(r *http.Request, name string, def int) int {
+	v, err := strconv.Atoi(r.URL.Query().Get(name))
+	if err != nil {
+		return def
+	}
+
+	return v
+}
+
+func valueOr(v, def string) string {
+	if v == "" {
+		return def
+	}
+
+	return v
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VitaliyJ/skyscanner"
+)
+
+var cabinClasses = map[string]skyscanner.CabinClass{
+	"economy":         skyscanner.CabinClassEconomy,
+	"premium-economy": skyscanner.CabinClassPremiumEconomy,
+	"business":        skyscanner.CabinClassBusiness,
+	"first":           skyscanner.CabinClassFirst,
+}
+
+func runSearch(ctx context.Context, c skyscanner.Client, args []string) (*output, error) {
+	fs := flag.NewFlagSet("search", flag.ExitOnError)
+	origin := fs.String("origin", "", "origin IATA code (required)")
+	destination := fs.String("destination", "", "destination IATA code (required)")
+	date := fs.String("date", "", "departure date as YYYY-MM-DD (required)")
+	returnDate := fs.String("return", "", "return date as YYYY-MM-DD, one way if empty")
+	adults := fs.Int("adults", 1, "number of adults")
+	children := fs.String("children", "", "comma separated children ages, e.g. 4,11")
+	cabin := fs.String("cabin", "economy", "cabin class: economy, premium-economy, business or first")
+	market := fs.String("market", "UK", "market code")
+	locale := fs.String("locale", "en-GB", "locale code")
+	currency := fs.String("currency", "GBP", "currency code")
+	limit := fs.Int("limit", 20, "max number of itineraries to print, all if zero")
+	_ = fs.Parse(args)
+
+	if *origin == "" || *destination == "" || *date == "" {
+		fs.Usage()
+		return nil, errors.New("origin, destination and date are required")
+	}
+
+	cabinClass, ok := cabinClasses[*cabin]
+	if !ok {
+		return nil, errors.New("unknown cabin class " + *cabin)
+	}
+
+	req, err := skyscanner.NewCreateRequest(*origin, *destination, *date, *returnDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var ages []int
+	if *children != "" {
+		for _, s := range strings.Split(*children, ",") {
+			age, err := strconv.Atoi(strings.TrimSpace(s))
+			if err != nil {
+				return nil, errors.New("invalid child age " + s)
+			}
+			ages = append(ages, age)
+		}
+	}
+
+	req.Query.Market = *market
+	req.Query.Locale = *locale
+	req.Query.Currency = *currency
+	req.Query.Adults = int32(*adults)
+	req.Query.ChildrenAges = ages
+	req.Query.CabinClass = cabinClass
+
+	resp, errResp := skyscanner.Search(ctx, c, req)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	out := &output{
+		raw:    resp,
+		header: []string{"itinerary", "price", "route", "departure", "arrival", "stops", "duration", "carriers"},
+	}
+	if resp.Content == nil || resp.Content.Results == nil {
+		return out, nil
+	}
+
+	r := resp.Content.Results
+	ids := make([]string, 0, len(r.Itineraries))
+	for id := range r.Itineraries {
+		ids = append(ids, id)
+	}
+	r.SortItineraries(ids, skyscanner.SortCheapest)
+	if *limit > 0 && len(ids) > *limit {
+		ids = ids[:*limit]
+	}
+
+	for _, id := range ids {
+		it := r.ResolveItinerary(id)
+		_, price := r.Itineraries[id].CheapestPricingOption()
+
+		var route, departures, arrivals, stops, carriers []string
+		for _, leg := range it.Legs {
+			route = append(route, leg.Origin.IATA+"-"+leg.Destination.IATA)
+			departures = append(departures, formatDatetime(leg.DepartureDateTime))
+			arrivals = append(arrivals, formatDatetime(leg.ArrivalDateTime))
+			stops = append(stops, strconv.Itoa(int(leg.StopCount)))
+			for _, carrier := range leg.MarketingCarriers {
+				carriers = append(carriers, carrier.Name)
+			}
+		}
+
+		out.rows = append(out.rows, []string{
+			id,
+			strconv.FormatFloat(price, 'f', 2, 64),
+			strings.Join(route, " / "),
+			strings.Join(departures, " / "),
+			strings.Join(arrivals, " / "),
+			strings.Join(stops, " / "),
+			(time.Duration(r.ItineraryDuration(r.Itineraries[id])) * time.Minute).String(),
+			strings.Join(carriers, ", "),
+		})
+	}
+
+	return out, nil
+}
+
+func runSuggest(ctx context.Context, c skyscanner.Client, args []string) (*output, error) {
+	fs := flag.NewFlagSet("suggest", flag.ExitOnError)
+	term := fs.String("term", "", "search term")
+	market := fs.String("market", "UK", "market code")
+	locale := fs.String("locale", "en-GB", "locale code")
+	limit := fs.Int("limit", 10, "max number of places, from 1 to 50")
+	destination := fs.Bool("destination", false, "rank places as destinations")
+	_ = fs.Parse(args)
+
+	resp, errResp := c.AutoSuggestFlights(ctx, &skyscanner.AutoSuggestFlightsRequest{
+		Query: skyscanner.AutoSuggestFlightsRequestQuery{
+			Locale:     *locale,
+			Market:     *market,
+			SearchTerm: *term,
+		},
+		Limit:         int32(*limit),
+		IsDestination: *destination,
+	})
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	out := &output{raw: resp, header: []string{"entityId", "iata", "name", "type", "city", "country"}}
+	for _, p := range resp.Places {
+		out.rows = append(out.rows, []string{p.EntityId, p.IATACode, p.Name, string(p.Type), p.CityName, p.CountryName})
+	}
+
+	return out, nil
+}
+
+func runLocales(ctx context.Context, c skyscanner.Client, _ []string) (*output, error) {
+	resp, errResp := c.Locales(ctx)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	out := &output{raw: resp, header: []string{"code", "name"}}
+	for _, l := range resp.Locales {
+		out.rows = append(out.rows, []string{l.Code, l.Name})
+	}
+
+	return out, nil
+}
+
+func runCurrencies(ctx context.Context, c skyscanner.Client, _ []string) (*output, error) {
+	resp, errResp := c.Currencies(ctx)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	out := &output{raw: resp, header: []string{"code", "symbol", "decimalDigits"}}
+	for _, cur := range resp.Currencies {
+		out.rows = append(out.rows, []string{cur.Code, cur.Symbol, strconv.Itoa(int(cur.DecimalDigits))})
+	}
+
+	return out, nil
+}
+
+func runMarkets(ctx context.Context, c skyscanner.Client, args []string) (*output, error) {
+	fs := flag.NewFlagSet("markets", flag.ExitOnError)
+	locale := fs.String("locale", "en-GB", "locale of the market names")
+	_ = fs.Parse(args)
+
+	resp, errResp := c.Markets(ctx, *locale)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	out := &output{raw: resp, header: []string{"code", "name"}}
+	for _, m := range resp.Markets {
+		out.rows = append(out.rows, []string{m.Code, m.Name})
+	}
+
+	return out, nil
+}
+
+func runNearestCulture(ctx context.Context, c skyscanner.Client, args []string) (*output, error) {
+	fs := flag.NewFlagSet("nearest-culture", flag.ExitOnError)
+	ip := fs.String("ip", "", "IP address (required)")
+	_ = fs.Parse(args)
+
+	if *ip == "" {
+		fs.Usage()
+		return nil, errors.New("ip is required")
+	}
+
+	resp, errResp := c.NearestCulture(ctx, *ip)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	return &output{
+		raw:    resp,
+		header: []string{"market", "locale", "currency"},
+		rows:   [][]string{{resp.Market.Code, resp.Locale.Code, resp.Currency.Code}},
+	}, nil
+}
+
+func formatDatetime(d skyscanner.LocalDatetime) string {
+	return d.Time().Format("2006-01-02 15:04")
+}
@@ -0,0 +1,135 @@
+// Command skyscanner is a command-line client of the SkyScanner partners API.
+//
+// Usage:
+//
+//	skyscanner [-config path] [-format table|json|csv] <command> [flags]
+//
+// Commands are search, suggest, locales, currencies, markets and nearest-culture.
+// The API key is read from the SKYSCANNER_API_KEY environment variable
+// or from the "apiKey" field of the JSON config file
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/VitaliyJ/skyscanner"
+)
+
+const apiKeyEnv = "SKYSCANNER_API_KEY"
+
+type command struct {
+	name  string
+	usage string
+	run   func(ctx context.Context, c skyscanner.Client, args []string) (*output, error)
+}
+
+var commands = []command{
+	{"search", "live flights search polled until complete", runSearch},
+	{"suggest", "autosuggest places for a search term", runSuggest},
+	{"locales", "supported locales", runLocales},
+	{"currencies", "supported currencies", runCurrencies},
+	{"markets", "supported markets for a locale", runMarkets},
+	{"nearest-culture", "market, locale and currency for an IP address", runNearestCulture},
+}
+
+type fileConfig struct {
+	APIKey string `json:"apiKey"`
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	fs := flag.NewFlagSet("skyscanner", flag.ExitOnError)
+	configPath := fs.String("config", defaultConfigPath(), "path to the JSON config file with the apiKey field")
+	format := fs.String("format", formatTable, "output format: table, json or csv")
+	timeout := fs.Duration("timeout", 2*time.Minute, "overall command timeout")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: skyscanner [flags] <command> [command flags]\n\nCommands:\n")
+		for _, c := range commands {
+			fmt.Fprintf(fs.Output(), "  %-16s %s\n", c.name, c.usage)
+		}
+		fmt.Fprintf(fs.Output(), "\nFlags:\n")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(os.Args[1:])
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("command is required")
+	}
+	if !validFormat(*format) {
+		return errors.New("unknown format " + *format)
+	}
+
+	var cmd *command
+	for i := range commands {
+		if commands[i].name == fs.Arg(0) {
+			cmd = &commands[i]
+		}
+	}
+	if cmd == nil {
+		return errors.New("unknown command " + fs.Arg(0))
+	}
+
+	apiKey, err := loadAPIKey(*configPath)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	c := skyscanner.NewClient(&skyscanner.Config{APIKey: apiKey})
+	out, err := cmd.run(ctx, c, fs.Args()[1:])
+	if err != nil {
+		return err
+	}
+
+	return out.write(os.Stdout, *format)
+}
+
+// loadAPIKey reads the key from the environment first and from the config file then
+func loadAPIKey(configPath string) (string, error) {
+	if key := os.Getenv(apiKeyEnv); key != "" {
+		return key, nil
+	}
+
+	if configPath != "" {
+		b, err := os.ReadFile(configPath)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+		if err == nil {
+			var cfg fileConfig
+			if err := json.Unmarshal(b, &cfg); err != nil {
+				return "", errors.New("config decoding error: " + err.Error())
+			}
+			if cfg.APIKey != "" {
+				return cfg.APIKey, nil
+			}
+		}
+	}
+
+	return "", errors.New("API key is not set: use the " + apiKeyEnv + " environment variable or the config file")
+}
+
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, "skyscanner", "config.json")
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatCSV   = "csv"
+)
+
+// output contains a command result. JSON output prints the raw value,
+// table and CSV outputs print the header and the rows
+type output struct {
+	raw    interface{}
+	header []string
+	rows   [][]string
+}
+
+// validFormat reports whether the output format is supported
+func validFormat(format string) bool {
+	switch format {
+	case formatTable, formatJSON, formatCSV:
+		return true
+	}
+
+	return false
+}
+
+func (o *output) write(w io.Writer, format string) error {
+	switch format {
+	case formatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(o.raw)
+	case formatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(o.header); err != nil {
+			return err
+		}
+		if err := cw.WriteAll(o.rows); err != nil {
+			return err
+		}
+		return cw.Error()
+	case formatTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		if _, err := io.WriteString(tw, strings.Join(o.header, "\t")+"\n"); err != nil {
+			return err
+		}
+		for _, row := range o.rows {
+			if _, err := io.WriteString(tw, strings.Join(row, "\t")+"\n"); err != nil {
+				return err
+			}
+		}
+		return tw.Flush()
+	default:
+		return errors.New("unknown format " + format)
+	}
+}
@@ -3,6 +3,32 @@ package skyscanner
 import "time"
 
 type Config struct {
-	APIKey         string
+	APIKey string
+	// KeyProvider picks API keys per request, see KeyPool.
+	// It takes precedence over APIKey: APIKey is ignored and keys set by APIKeyInterceptor are overwritten
+	KeyProvider KeyProvider
+	// QueriesTimeout is the default total timeout of endpoints without Timeouts set. Default is 15 seconds
 	QueriesTimeout time.Duration
+	// Timeouts are connect, TLS, response header, body read and total timeouts per endpoint
+	Timeouts EndpointTimeouts
+	// PollInterval is a delay between polls of an incomplete search. Default is 1 second
+	PollInterval time.Duration
+	// CircuitBreaker fails requests fast while an endpoint group keeps failing. Requests are never failed fast if nil
+	CircuitBreaker *CircuitBreaker
+	// RateLimiter limits outgoing requests. Requests are not limited if nil
+	RateLimiter RateLimiter
+	// SearchHook receives lifecycle events of Search. Events are not emitted if nil
+	SearchHook SearchHook
+	// Tracer starts a span per search and per HTTP call. Spans are not recorded if nil
+	Tracer Tracer
+	// Metrics records request and search metrics. Metrics are not recorded if nil
+	Metrics Metrics
+	// Logger logs requests and responses. *slog.Logger can be used. Nothing is logged if nil
+	Logger Logger
+	// LogOptions configures levels, bodies and redaction of request and response records
+	LogOptions LogOptions
+	// Interceptors wrap every outbound request, the first one is the outermost.
+	// Default is DefaultInterceptors(APIKey), a non-nil slice replaces them.
+	// KeyProviderInterceptor is added inside them if KeyProvider is set
+	Interceptors []Interceptor
 }
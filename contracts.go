@@ -3,6 +3,7 @@ package skyscanner
 import (
 	"context"
 	"strconv"
+	"time"
 )
 
 const (
@@ -42,7 +43,7 @@ const (
 	// rather than the airline.
 	// If a flights is delayed and the travellers miss their connection
 	// they can contact the travel agent to help with the rebooking
-	TransferTypeProtectedSelfTransfer TransferType = "TRANSFER_TYPE_UNSPECIFIED"
+	TransferTypeProtectedSelfTransfer TransferType = "TRANSFER_TYPE_PROTECTED_SELF_TRANSFER"
 
 	PlaceTypeUnspecified PlaceType = "PLACE_TYPE_UNSPECIFIED"
 	PlaceTypeAirport     PlaceType = "PLACE_TYPE_AIRPORT"
@@ -74,6 +75,11 @@ type Client interface {
 	AutoSuggestFlights(ctx context.Context, req *AutoSuggestFlightsRequest) (*AutoSuggestFlightsResponse, *ErrorResponse)
 }
 
+// RateLimiter blocks until a request is allowed to be sent
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
 // Price object
 type Price struct {
 	Amount string    `json:"amount"`
@@ -121,3 +127,26 @@ func (p Price) ToFloat() (float64, error) {
 		return float64(a), nil
 	}
 }
+
+// Time converts LocalDatetime to time.Time.
+// The value has no timezone, so the UTC location is used
+func (d LocalDatetime) Time() time.Time {
+	return time.Date(int(d.Year), time.Month(d.Month), int(d.Day), int(d.Hour), int(d.Minute), int(d.Second), 0, time.UTC)
+}
+
+// TimeOfDay returns the time elapsed since midnight
+func (d LocalDatetime) TimeOfDay() time.Duration {
+	return time.Duration(d.Hour)*time.Hour + time.Duration(d.Minute)*time.Minute + time.Duration(d.Second)*time.Second
+}
+
+// NewLocalDatetime converts time.Time to LocalDatetime keeping the wall clock of the time's location
+func NewLocalDatetime(t time.Time) LocalDatetime {
+	return LocalDatetime{
+		Year:   int32(t.Year()),
+		Month:  int32(t.Month()),
+		Day:    int32(t.Day()),
+		Hour:   int32(t.Hour()),
+		Minute: int32(t.Minute()),
+		Second: int32(t.Second()),
+	}
+}
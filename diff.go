@@ -0,0 +1,246 @@
+package skyscanner
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	ChangeItineraryAdded       ChangeType = "ITINERARY_ADDED"
+	ChangeItineraryRemoved     ChangeType = "ITINERARY_REMOVED"
+	ChangePricingOptionAdded   ChangeType = "PRICING_OPTION_ADDED"
+	ChangePricingOptionRemoved ChangeType = "PRICING_OPTION_REMOVED"
+	ChangePriceChanged         ChangeType = "PRICE_CHANGED"
+	ChangeAgentAdded           ChangeType = "AGENT_ADDED"
+	ChangeAgentRemoved         ChangeType = "AGENT_REMOVED"
+	ChangeStatsMinPrice        ChangeType = "STATS_MIN_PRICE_CHANGED"
+)
+
+// ChangeType is a type of change between two results
+type ChangeType string
+
+// Change describes a single change between two results
+type Change struct {
+	Type        ChangeType `json:"type"`
+	ItineraryID string     `json:"itineraryId,omitempty"`
+	// PricingOption identifies the pricing option by its agents and transfer type
+	PricingOption string `json:"pricingOption,omitempty"`
+	AgentID       string `json:"agentId,omitempty"`
+	// StatsCategory is one of "total", "direct", "oneStop" or "twoPlusStops"
+	StatsCategory string `json:"statsCategory,omitempty"`
+	OldPrice      *Price `json:"oldPrice,omitempty"`
+	NewPrice      *Price `json:"newPrice,omitempty"`
+	// Delta is the new price minus the old price in major currency units
+	Delta float64 `json:"delta,omitempty"`
+}
+
+// ResultsDiff contains changes between two results
+type ResultsDiff struct {
+	Changes []Change `json:"changes"`
+}
+
+// DiffResponses compares results and stats of two responses.
+// If a response has no stats, they are computed from its results
+func DiffResponses(prev, next *CreatePollResponse) *ResultsDiff {
+	prevResults, nextResults := responseResults(prev), responseResults(next)
+
+	d := DiffResults(prevResults, nextResults)
+	d.Changes = append(d.Changes, diffStats(responseStats(prev, prevResults), responseStats(next, nextResults))...)
+
+	return d
+}
+
+// DiffResults reports added and removed itineraries, pricing options and agents,
+// and pricing options whose price changed. Changes are sorted by itinerary and agent IDs
+func DiffResults(prev, next *Results) *ResultsDiff {
+	if prev == nil {
+		prev = &Results{}
+	}
+	if next == nil {
+		next = &Results{}
+	}
+
+	changes := make([]Change, 0)
+	for _, id := range sortedKeys(prev.Itineraries, next.Itineraries) {
+		oldIt, inOld := prev.Itineraries[id]
+		newIt, inNew := next.Itineraries[id]
+		switch {
+		case !inOld:
+			c := Change{Type: ChangeItineraryAdded, ItineraryID: id}
+			if po, _ := newIt.CheapestPricingOption(); po != nil {
+				c.NewPrice = &po.Price
+			}
+			changes = append(changes, c)
+		case !inNew:
+			c := Change{Type: ChangeItineraryRemoved, ItineraryID: id}
+			if po, _ := oldIt.CheapestPricingOption(); po != nil {
+				c.OldPrice = &po.Price
+			}
+			changes = append(changes, c)
+		default:
+			changes = append(changes, diffPricingOptions(id, oldIt, newIt)...)
+		}
+	}
+
+	for _, id := range sortedKeys(prev.Agents, next.Agents) {
+		_, inOld := prev.Agents[id]
+		_, inNew := next.Agents[id]
+		switch {
+		case !inOld:
+			changes = append(changes, Change{Type: ChangeAgentAdded, AgentID: id})
+		case !inNew:
+			changes = append(changes, Change{Type: ChangeAgentRemoved, AgentID: id})
+		}
+	}
+
+	return &ResultsDiff{Changes: changes}
+}
+
+// PricingOptionKey identifies a pricing option of an itinerary across polls and searches
+func PricingOptionKey(po PricingOption) string {
+	agents := append([]string(nil), po.AgentIds...)
+	sort.Strings(agents)
+
+	return strings.Join(agents, ",") + "|" + string(po.TransferType)
+}
+
+func diffPricingOptions(itineraryID string, prev, next ItineraryResult) []Change {
+	oldOptions, newOptions := pricingOptionsByKey(prev), pricingOptionsByKey(next)
+
+	changes := make([]Change, 0)
+	for _, key := range sortedKeys(oldOptions, newOptions) {
+		oldPo, inOld := oldOptions[key]
+		newPo, inNew := newOptions[key]
+		switch {
+		case !inOld:
+			changes = append(changes, Change{Type: ChangePricingOptionAdded, ItineraryID: itineraryID, PricingOption: key, NewPrice: knownPrice(newPo.Price)})
+		case !inNew:
+			changes = append(changes, Change{Type: ChangePricingOptionRemoved, ItineraryID: itineraryID, PricingOption: key, OldPrice: knownPrice(oldPo.Price)})
+		default:
+			if c, ok := priceChange(oldPo.Price, newPo.Price); ok {
+				c.Type = ChangePriceChanged
+				c.ItineraryID = itineraryID
+				c.PricingOption = key
+				changes = append(changes, c)
+			}
+		}
+	}
+
+	return changes
+}
+
+// pricingOptionsByKey returns the cheapest pricing option for every key
+func pricingOptionsByKey(it ItineraryResult) map[string]PricingOption {
+	options := make(map[string]PricingOption, len(it.PricingOptions))
+	for _, po := range it.PricingOptions {
+		key := PricingOptionKey(po)
+		existing, ok := options[key]
+		if !ok {
+			options[key] = po
+			continue
+		}
+
+		cheapest, _ := ItineraryResult{PricingOptions: []PricingOption{existing, po}}.CheapestPricingOption()
+		if cheapest != nil {
+			options[key] = *cheapest
+		}
+	}
+
+	return options
+}
+
+func diffStats(prev, next *Stats) []Change {
+	if prev == nil || next == nil {
+		return nil
+	}
+
+	categories := []struct {
+		name       string
+		prev, next ItinerarySummary
+	}{
+		{"total", prev.Itineraries.Total, next.Itineraries.Total},
+		{"direct", prev.Itineraries.Stops.Direct.Total, next.Itineraries.Stops.Direct.Total},
+		{"oneStop", prev.Itineraries.Stops.OneStop.Total, next.Itineraries.Stops.OneStop.Total},
+		{"twoPlusStops", prev.Itineraries.Stops.TwoPlusStops.Total, next.Itineraries.Stops.TwoPlusStops.Total},
+	}
+
+	changes := make([]Change, 0)
+	for _, cat := range categories {
+		if c, ok := priceChange(cat.prev.MinPrice, cat.next.MinPrice); ok {
+			c.Type = ChangeStatsMinPrice
+			c.StatsCategory = cat.name
+			changes = append(changes, c)
+		}
+	}
+
+	return changes
+}
+
+// priceChange returns a change with the prices and the delta if the prices differ
+func priceChange(prev, next Price) (Change, bool) {
+	if prev == next {
+		return Change{}, false
+	}
+
+	c := Change{}
+	if prev.Amount != "" {
+		c.OldPrice = &prev
+	}
+	if next.Amount != "" {
+		c.NewPrice = &next
+	}
+
+	oldValue, oldErr := prev.ToFloat()
+	newValue, newErr := next.ToFloat()
+	if oldErr != nil || newErr != nil {
+		return c, true
+	}
+	if oldValue == newValue && prev.Amount != "" && next.Amount != "" {
+		return Change{}, false
+	}
+	if prev.Amount != "" && next.Amount != "" {
+		c.Delta = newValue - oldValue
+	}
+
+	return c, true
+}
+
+// knownPrice returns nil for an empty price
+func knownPrice(p Price) *Price {
+	if p.Amount == "" {
+		return nil
+	}
+
+	return &p
+}
+
+func responseResults(resp *CreatePollResponse) *Results {
+	if resp == nil || resp.Content == nil || resp.Content.Results == nil {
+		return &Results{}
+	}
+
+	return resp.Content.Results
+}
+
+func responseStats(resp *CreatePollResponse, r *Results) *Stats {
+	if resp != nil && resp.Content != nil && resp.Content.Stats != nil {
+		return resp.Content.Stats
+	}
+
+	return r.ComputeStats()
+}
+
+func sortedKeys[T any](a, b map[string]T) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
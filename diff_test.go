@@ -0,0 +1,154 @@
+package skyscanner
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffResults(t *testing.T) {
+	milli := func(amount string) *Price {
+		return &Price{Amount: amount, Unit: PriceUnitMilli}
+	}
+	withOption := func(id string, po PricingOption) func(r *Results) {
+		return func(r *Results) {
+			it := r.Itineraries[id]
+			it.PricingOptions = []PricingOption{po}
+			r.Itineraries[id] = it
+		}
+	}
+	const onestopOption = "ota|" + string(TransferTypeSelfTransfer)
+
+	tests := []struct {
+		name     string
+		modify   func(r *Results)
+		nilPrev  bool
+		want     []Change
+		wantNone bool
+	}{
+		{name: "same results", wantNone: true},
+		{
+			name:   "price changed",
+			modify: withOption("onestop", PricingOption{Price: *milli("250000"), AgentIds: []string{"ota"}, TransferType: TransferTypeSelfTransfer}),
+			want: []Change{
+				{Type: ChangePriceChanged, ItineraryID: "onestop", PricingOption: onestopOption, OldPrice: milli("300000"), NewPrice: milli("250000"), Delta: -50},
+			},
+		},
+		{
+			name:     "same price in another unit",
+			modify:   withOption("onestop", PricingOption{Price: Price{Amount: "300", Unit: PriceUnitWhole}, AgentIds: []string{"ota"}, TransferType: TransferTypeSelfTransfer}),
+			wantNone: true,
+		},
+		{
+			name: "pricing option replaced",
+			modify: withOption("change", PricingOption{
+				Price: *milli("200000"), AgentIds: []string{"ota"}, TransferType: TransferTypeProtectedSelfTransfer,
+			}),
+			want: []Change{
+				{Type: ChangePricingOptionRemoved, ItineraryID: "change", PricingOption: "ba|" + string(TransferTypeManaged)},
+				{Type: ChangePricingOptionRemoved, ItineraryID: "change", PricingOption: "gone|" + string(TransferTypeProtectedSelfTransfer), OldPrice: milli("200000")},
+				{Type: ChangePricingOptionAdded, ItineraryID: "change", PricingOption: "ota|" + string(TransferTypeProtectedSelfTransfer), NewPrice: milli("200000")},
+			},
+		},
+		{
+			name: "itineraries and agents added and removed",
+			modify: func(r *Results) {
+				r.Itineraries["new"] = ItineraryResult{LegIds: []string{"leg-direct"}}
+				delete(r.Itineraries, "direct")
+				r.Agents["new"] = Agent{Name: "New agent"}
+				delete(r.Agents, "ota")
+			},
+			want: []Change{
+				{Type: ChangeItineraryRemoved, ItineraryID: "direct", OldPrice: milli("500000")},
+				{Type: ChangeItineraryAdded, ItineraryID: "new"},
+				{Type: ChangeAgentAdded, AgentID: "new"},
+				{Type: ChangeAgentRemoved, AgentID: "ota"},
+			},
+		},
+		{
+			name:    "no previous results",
+			nilPrev: true,
+			want: []Change{
+				{Type: ChangeItineraryAdded, ItineraryID: "change", NewPrice: milli("200000")},
+				{Type: ChangeItineraryAdded, ItineraryID: "direct", NewPrice: milli("500000")},
+				{Type: ChangeItineraryAdded, ItineraryID: "onestop", NewPrice: milli("300000")},
+				{Type: ChangeAgentAdded, AgentID: "ba"},
+				{Type: ChangeAgentAdded, AgentID: "ota"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev, next := testResults(), testResults()
+			if tt.nilPrev {
+				prev = nil
+			}
+			if tt.modify != nil {
+				tt.modify(next)
+			}
+
+			got := DiffResults(prev, next).Changes
+			if tt.wantNone {
+				if len(got) != 0 {
+					t.Errorf("got changes %+v, want none", got)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got  %+v\nwant %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffResponsesStats(t *testing.T) {
+	prev, next := testResults(), testResults()
+	delete(next.Itineraries, "direct")
+	next.Itineraries["onestop"] = ItineraryResult{
+		LegIds:         []string{"leg-onestop"},
+		PricingOptions: []PricingOption{{Price: Price{Amount: "150000", Unit: PriceUnitMilli}, AgentIds: []string{"ota"}}},
+	}
+
+	d := DiffResponses(
+		&CreatePollResponse{Content: &Content{Results: prev}},
+		&CreatePollResponse{Content: &Content{Results: next}},
+	)
+
+	var got []Change
+	for _, c := range d.Changes {
+		if c.Type == ChangeStatsMinPrice {
+			got = append(got, c)
+		}
+	}
+	want := []Change{
+		{
+			Type: ChangeStatsMinPrice, StatsCategory: "total",
+			OldPrice: &Price{Amount: "200000", Unit: PriceUnitMilli}, NewPrice: &Price{Amount: "150000", Unit: PriceUnitMilli}, Delta: -50,
+		},
+		{Type: ChangeStatsMinPrice, StatsCategory: "direct", OldPrice: &Price{Amount: "500000", Unit: PriceUnitMilli}},
+		{
+			Type: ChangeStatsMinPrice, StatsCategory: "oneStop",
+			OldPrice: &Price{Amount: "200000", Unit: PriceUnitMilli}, NewPrice: &Price{Amount: "150000", Unit: PriceUnitMilli}, Delta: -50,
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got  %+v\nwant %+v", got, want)
+	}
+}
+
+func TestPricingOptionKey(t *testing.T) {
+	a := PricingOption{AgentIds: []string{"ota", "ba"}, TransferType: TransferTypeManaged}
+	b := PricingOption{AgentIds: []string{"ba", "ota"}, TransferType: TransferTypeManaged}
+
+	if PricingOptionKey(a) != PricingOptionKey(b) {
+		t.Errorf("keys %q and %q differ by the agents order", PricingOptionKey(a), PricingOptionKey(b))
+	}
+	if a.AgentIds[0] != "ota" {
+		t.Error("the key sorts the agent IDs in place")
+	}
+
+	b.TransferType = TransferTypeSelfTransfer
+	if PricingOptionKey(a) == PricingOptionKey(b) {
+		t.Error("keys of different transfer types are equal")
+	}
+}
@@ -0,0 +1,180 @@
+package skyscanner
+
+const (
+	defaultDistanceUplift = 0.08
+	domesticHaulKm        = 500
+	longHaulKm            = 3700
+)
+
+// EmissionFactors contain kg of CO2 per passenger per kilometer by cabin class.
+// CabinClassUnspecified is used for classes missing in the map
+type EmissionFactors map[CabinClass]float64
+
+// EmissionsCalculator estimates CO2 emissions of flights per passenger.
+//
+// Methodology:
+//  1. A segment distance is the great-circle distance between its airports
+//     increased by DistanceUplift to account for indirect routing and holding patterns.
+//  2. The flight is banded by the great-circle distance: domestic below 500 km,
+//     short haul below 3700 km and long haul otherwise.
+//  3. The distance is multiplied by the factor of the band and the cabin class.
+//     Premium cabins take more space, so their factors are higher.
+//
+// The default factors are approximations of the UK DEFRA greenhouse gas conversion factors
+// for passenger flights without radiative forcing. Short haul premium economy and first classes
+// use the business factor since DEFRA does not distinguish them.
+// Segments with airports missing in the table are not estimated
+type EmissionsCalculator struct {
+	Airports       AirportTable
+	DistanceUplift float64
+	Domestic       EmissionFactors
+	ShortHaul      EmissionFactors
+	LongHaul       EmissionFactors
+}
+
+// SegmentEmissions contains emissions estimate of a segment
+type SegmentEmissions struct {
+	SegmentID  string  `json:"segmentId"`
+	DistanceKm float64 `json:"distanceKm"`
+	KgCO2      float64 `json:"kgCo2"`
+	// Estimated is false if the segment airports are unknown
+	Estimated bool `json:"estimated"`
+}
+
+// LegEmissions contains emissions estimate of a leg
+type LegEmissions struct {
+	LegID      string             `json:"legId"`
+	Segments   []SegmentEmissions `json:"segments"`
+	DistanceKm float64            `json:"distanceKm"`
+	KgCO2      float64            `json:"kgCo2"`
+	// Complete is false if any segment is not estimated
+	Complete bool `json:"complete"`
+}
+
+// ItineraryEmissions contains emissions estimate of an itinerary per passenger
+type ItineraryEmissions struct {
+	CabinClass CabinClass     `json:"cabinClass"`
+	Legs       []LegEmissions `json:"legs"`
+	DistanceKm float64        `json:"distanceKm"`
+	KgCO2      float64        `json:"kgCo2"`
+	// Complete is false if any segment is not estimated
+	Complete bool `json:"complete"`
+}
+
+// TripEmissions contains aggregated emissions of several itineraries for all the passengers
+type TripEmissions struct {
+	Passengers        int32   `json:"passengers"`
+	DistanceKm        float64 `json:"distanceKm"`
+	KgCO2PerPassenger float64 `json:"kgCo2PerPassenger"`
+	KgCO2             float64 `json:"kgCo2"`
+	// Complete is false if any segment is not estimated
+	Complete bool `json:"complete"`
+}
+
+// NewEmissionsCalculator returns a calculator with the embedded airports table and the default factors
+func NewEmissionsCalculator() *EmissionsCalculator {
+	return &EmissionsCalculator{
+		Airports:       DefaultAirports(),
+		DistanceUplift: defaultDistanceUplift,
+		Domestic: EmissionFactors{
+			CabinClassUnspecified: 0.1307,
+		},
+		ShortHaul: EmissionFactors{
+			CabinClassUnspecified:    0.0796,
+			CabinClassEconomy:        0.0796,
+			CabinClassPremiumEconomy: 0.1194,
+			CabinClassBusiness:       0.1194,
+			CabinClassFirst:          0.1194,
+		},
+		LongHaul: EmissionFactors{
+			CabinClassUnspecified:    0.0780,
+			CabinClassEconomy:        0.0780,
+			CabinClassPremiumEconomy: 0.1248,
+			CabinClassBusiness:       0.2262,
+			CabinClassFirst:          0.3120,
+		},
+	}
+}
+
+// Estimate estimates emissions of the itinerary per passenger flying the cabin class
+// and stores them in the itinerary Emissions field
+func (c *EmissionsCalculator) Estimate(ri *ResolvedItinerary, cabin CabinClass) *ItineraryEmissions {
+	ie := &ItineraryEmissions{
+		CabinClass: cabin,
+		Legs:       make([]LegEmissions, 0, len(ri.Legs)),
+		Complete:   true,
+	}
+
+	for _, leg := range ri.Legs {
+		le := LegEmissions{
+			LegID:    leg.ID,
+			Segments: make([]SegmentEmissions, 0, len(leg.Segments)),
+			Complete: true,
+		}
+		for _, s := range leg.Segments {
+			se := c.segment(s, cabin)
+			le.Segments = append(le.Segments, se)
+			le.DistanceKm += se.DistanceKm
+			le.KgCO2 += se.KgCO2
+			le.Complete = le.Complete && se.Estimated
+		}
+
+		ie.Legs = append(ie.Legs, le)
+		ie.DistanceKm += le.DistanceKm
+		ie.KgCO2 += le.KgCO2
+		ie.Complete = ie.Complete && le.Complete
+	}
+
+	ri.Emissions = ie
+
+	return ie
+}
+
+// SumEmissions aggregates emissions of the trip itineraries for the passengers
+func SumEmissions(passengers int32, itineraries ...*ItineraryEmissions) *TripEmissions {
+	t := &TripEmissions{Passengers: passengers, Complete: true}
+	for _, ie := range itineraries {
+		t.DistanceKm += ie.DistanceKm
+		t.KgCO2PerPassenger += ie.KgCO2
+		t.Complete = t.Complete && ie.Complete
+	}
+	t.KgCO2 = t.KgCO2PerPassenger * float64(passengers)
+
+	return t
+}
+
+func (c *EmissionsCalculator) segment(s ResolvedSegment, cabin CabinClass) SegmentEmissions {
+	se := SegmentEmissions{SegmentID: s.ID}
+
+	distance, ok := c.Airports.Distance(placeIATA(s.Origin), placeIATA(s.Destination))
+	if !ok {
+		return se
+	}
+
+	factors := c.LongHaul
+	switch {
+	case distance < domesticHaulKm:
+		factors = c.Domestic
+	case distance < longHaulKm:
+		factors = c.ShortHaul
+	}
+
+	factor, ok := factors[cabin]
+	if !ok {
+		factor = factors[CabinClassUnspecified]
+	}
+
+	se.DistanceKm = distance * (1 + c.DistanceUplift)
+	se.KgCO2 = se.DistanceKm * factor
+	se.Estimated = true
+
+	return se
+}
+
+func placeIATA(p Place) string {
+	if p.IATA != "" {
+		return p.IATA
+	}
+
+	return p.EntityId
+}
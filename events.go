@@ -0,0 +1,108 @@
+package skyscanner
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const (
+	SearchEventCreated        SearchEventType = "SearchCreated"
+	SearchEventPollReceived   SearchEventType = "PollReceived"
+	SearchEventCompleted      SearchEventType = "SearchCompleted"
+	SearchEventFailed         SearchEventType = "SearchFailed"
+	SearchEventSessionExpired SearchEventType = "SessionExpired"
+)
+
+type SearchEventType string
+
+// SearchEvent describes a change of the search lifecycle
+type SearchEvent struct {
+	Type         SearchEventType `json:"type"`
+	Time         time.Time       `json:"time"`
+	SessionToken string          `json:"sessionToken,omitempty"`
+	// Action and Status are set for SearchCreated and PollReceived
+	Action ResponseAction `json:"action,omitempty"`
+	Status ResponseStatus `json:"status,omitempty"`
+	// Counts are the accumulated results counts, set for SearchCreated and PollReceived
+	Counts *ResultCounts `json:"counts,omitempty"`
+	// Stats are set for SearchCompleted
+	Stats *Stats `json:"stats,omitempty"`
+	// Error is set for SearchFailed and SessionExpired
+	Error *ErrorResponse `json:"error,omitempty"`
+}
+
+// ResultCounts contains numbers of entities in the results
+type ResultCounts struct {
+	Itineraries int `json:"itineraries"`
+	Legs        int `json:"legs"`
+	Segments    int `json:"segments"`
+	Agents      int `json:"agents"`
+}
+
+// SearchHook receives search lifecycle events of Search.
+// It is called synchronously from the polling loop, so slow hooks should hand events off
+type SearchHook interface {
+	OnSearchEvent(ctx context.Context, e SearchEvent)
+}
+
+// SearchHookFunc is an adapter to use ordinary functions as SearchHook
+type SearchHookFunc func(ctx context.Context, e SearchEvent)
+
+// OnSearchEvent calls f(ctx, e)
+func (f SearchHookFunc) OnSearchEvent(ctx context.Context, e SearchEvent) {
+	f(ctx, e)
+}
+
+// MultiSearchHook passes every event to all the hooks in order
+func MultiSearchHook(hooks ...SearchHook) SearchHook {
+	return SearchHookFunc(func(ctx context.Context, e SearchEvent) {
+		for _, h := range hooks {
+			h.OnSearchEvent(ctx, e)
+		}
+	})
+}
+
+// hooked reports whether events are consumed, so the event data is not computed for nothing
+func (s SearchSettings) hooked() bool {
+	return s.SearchHook != nil
+}
+
+func (s SearchSettings) emit(ctx context.Context, e SearchEvent) {
+	if !s.hooked() {
+		return
+	}
+
+	e.Time = time.Now()
+	s.SearchHook.OnSearchEvent(ctx, e)
+}
+
+func (s SearchSettings) emitResponse(ctx context.Context, t SearchEventType, resp *CreatePollResponse) {
+	if !s.hooked() {
+		return
+	}
+
+	s.emit(ctx, SearchEvent{
+		Type:         t,
+		SessionToken: resp.SessionToken,
+		Action:       resp.Action,
+		Status:       resp.Status,
+		Counts:       resultCounts(resp),
+	})
+}
+
+func resultCounts(resp *CreatePollResponse) *ResultCounts {
+	r := responseResults(resp)
+
+	return &ResultCounts{
+		Itineraries: len(r.Itineraries),
+		Legs:        len(r.Legs),
+		Segments:    len(r.Segments),
+		Agents:      len(r.Agents),
+	}
+}
+
+// isSessionExpired reports whether the poll error means the session is no longer available
+func isSessionExpired(errResp *ErrorResponse) bool {
+	return errResp.Code == http.StatusNotFound || errResp.Code == http.StatusGone
+}
@@ -0,0 +1,180 @@
+package skyscanner
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	flatTimeLayout = "2006-01-02T15:04:05"
+	flatSeparator  = "|"
+)
+
+// FlatRowColumns are the CSV header columns in the FlatRow.Values order
+var FlatRowColumns = []string{
+	"itinerary_id",
+	"pricing_option_index",
+	"leg_index",
+	"leg_id",
+	"origin_iata",
+	"destination_iata",
+	"departure_time",
+	"arrival_time",
+	"carrier_iata",
+	"carrier_names",
+	"stops",
+	"duration_minutes",
+	"agent_ids",
+	"agent_names",
+	"price",
+	"transfer_type",
+}
+
+// FlatRow is a single itinerary pricing option leg with resolved references.
+// Multiple values are joined with the "|" separator. All columns are scalar,
+// so rows can be loaded to spreadsheets and columnar storages as is
+type FlatRow struct {
+	ItineraryID        string `json:"itinerary_id"`
+	PricingOptionIndex int    `json:"pricing_option_index"`
+	LegIndex           int    `json:"leg_index"`
+	LegID              string `json:"leg_id"`
+	OriginIATA         string `json:"origin_iata"`
+	DestinationIATA    string `json:"destination_iata"`
+	// DepartureTime and ArrivalTime are local airport times in the 2006-01-02T15:04:05 format
+	DepartureTime   string `json:"departure_time"`
+	ArrivalTime     string `json:"arrival_time"`
+	CarrierIATA     string `json:"carrier_iata"`
+	CarrierNames    string `json:"carrier_names"`
+	Stops           int32  `json:"stops"`
+	DurationMinutes int32  `json:"duration_minutes"`
+	AgentIDs        string `json:"agent_ids"`
+	AgentNames      string `json:"agent_names"`
+	// Price of the pricing option in major currency units
+	Price        float64      `json:"price"`
+	TransferType TransferType `json:"transfer_type"`
+}
+
+// Values returns the row values in the FlatRowColumns order
+func (row FlatRow) Values() []string {
+	return []string{
+		row.ItineraryID,
+		strconv.Itoa(row.PricingOptionIndex),
+		strconv.Itoa(row.LegIndex),
+		row.LegID,
+		row.OriginIATA,
+		row.DestinationIATA,
+		row.DepartureTime,
+		row.ArrivalTime,
+		row.CarrierIATA,
+		row.CarrierNames,
+		strconv.Itoa(int(row.Stops)),
+		strconv.Itoa(int(row.DurationMinutes)),
+		row.AgentIDs,
+		row.AgentNames,
+		strconv.FormatFloat(row.Price, 'f', -1, 64),
+		string(row.TransferType),
+	}
+}
+
+// EachFlatRow calls fn for every itinerary × pricing option × leg in the itinerary ID order.
+// Rows are built one by one, iteration stops on the first fn error
+func (r *Results) EachFlatRow(fn func(FlatRow) error) error {
+	ids := make([]string, 0, len(r.Itineraries))
+	for id := range r.Itineraries {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		it := r.Itineraries[id]
+		for poIdx, po := range it.PricingOptions {
+			price, _ := po.Price.ToFloat()
+			agentIDs, agentNames := r.agentColumns(po.AgentIds)
+
+			for legIdx, legID := range it.LegIds {
+				leg, ok := r.Legs[legID]
+				if !ok {
+					continue
+				}
+
+				carrierIATA, carrierNames := r.carrierColumns(leg.MarketingCarrierIds)
+				row := FlatRow{
+					ItineraryID:        id,
+					PricingOptionIndex: poIdx,
+					LegIndex:           legIdx,
+					LegID:              legID,
+					OriginIATA:         r.placeCode(leg.OriginPlaceID),
+					DestinationIATA:    r.placeCode(leg.DestinationPlaceID),
+					DepartureTime:      leg.DepartureDateTime.Time().Format(flatTimeLayout),
+					ArrivalTime:        leg.ArrivalDateTime.Time().Format(flatTimeLayout),
+					CarrierIATA:        carrierIATA,
+					CarrierNames:       carrierNames,
+					Stops:              leg.StopCount,
+					DurationMinutes:    leg.DurationInMinutes,
+					AgentIDs:           agentIDs,
+					AgentNames:         agentNames,
+					Price:              price,
+					TransferType:       po.TransferType,
+				}
+				if err := fn(row); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// WriteCSV streams flat rows of the results as CSV with the FlatRowColumns header
+func WriteCSV(w io.Writer, r *Results) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(FlatRowColumns); err != nil {
+		return err
+	}
+
+	err := r.EachFlatRow(func(row FlatRow) error {
+		return cw.Write(row.Values())
+	})
+	if err != nil {
+		return err
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// WriteNDJSON streams flat rows of the results as newline delimited JSON objects
+func WriteNDJSON(w io.Writer, r *Results) error {
+	enc := json.NewEncoder(w)
+
+	return r.EachFlatRow(func(row FlatRow) error {
+		return enc.Encode(row)
+	})
+}
+
+func (r *Results) agentColumns(agentIDs []string) (string, string) {
+	names := make([]string, 0, len(agentIDs))
+	for _, id := range agentIDs {
+		names = append(names, r.Agents[id].Name)
+	}
+
+	return strings.Join(agentIDs, flatSeparator), strings.Join(names, flatSeparator)
+}
+
+func (r *Results) carrierColumns(carrierIDs []string) (string, string) {
+	codes := make([]string, 0, len(carrierIDs))
+	names := make([]string, 0, len(carrierIDs))
+	for _, id := range carrierIDs {
+		c := r.Carriers[id]
+		codes = append(codes, c.IATA)
+		names = append(names, c.Name)
+	}
+
+	return strings.Join(codes, flatSeparator), strings.Join(names, flatSeparator)
+}
@@ -0,0 +1,97 @@
+package skyscanner
+
+import "context"
+
+// FanOutSearchRequest contains multi-origin and multi-destination search attributes
+type FanOutSearchRequest struct {
+	// Query is a template for every search. Its QueryLegs are ignored
+	Query        CreateRequestQuery
+	Origins      []PlaceID
+	Destinations []PlaceID
+	// Date of the outbound legs. Time fields are ignored
+	Date LocalDatetime
+	// ReturnDate of the inbound legs. Searches are one way if nil
+	ReturnDate *LocalDatetime
+	// Workers is a number of concurrent searches. Default is 4
+	Workers int
+}
+
+// FanOutSearchResponse contains merged results of the fan-out searches
+type FanOutSearchResponse struct {
+	*MergedResults
+	// Sessions are indexed the same way as ItinerarySource.SessionIndex
+	Sessions []FanOutSession `json:"sessions"`
+}
+
+// FanOutSession describes a single search of the fan-out
+type FanOutSession struct {
+	Origin       PlaceID        `json:"origin"`
+	Destination  PlaceID        `json:"destination"`
+	SessionToken string         `json:"sessionToken,omitempty"`
+	Status       ResponseStatus `json:"status,omitempty"`
+	Error        *ErrorResponse `json:"error,omitempty"`
+}
+
+// FanOutSearch runs a live search for every origin and destination pair concurrently
+// and merges their results. The client's rate limiter applies to all the searches.
+// An error is returned only if every search failed, otherwise failures are reported in the sessions
+func FanOutSearch(ctx context.Context, c Client, req *FanOutSearchRequest) (*FanOutSearchResponse, *ErrorResponse) {
+	if len(req.Origins) == 0 || len(req.Destinations) == 0 {
+		return nil, internalErrorResponse("at least one origin and one destination are required")
+	}
+
+	sessions := make([]FanOutSession, 0, len(req.Origins)*len(req.Destinations))
+	for _, o := range req.Origins {
+		for _, d := range req.Destinations {
+			sessions = append(sessions, FanOutSession{Origin: o, Destination: d})
+		}
+	}
+
+	workers := req.Workers
+	if workers == 0 {
+		workers = defaultSearchWorkers
+	}
+
+	responses := make([]*CreatePollResponse, len(sessions))
+	runWorkers(ctx, workers, len(sessions), func(i int) {
+		s := &sessions[i]
+		query := req.Query
+		query.QueryLegs = []*QueryLeg{newQueryLeg(s.Origin, s.Destination, req.Date)}
+		if req.ReturnDate != nil {
+			query.QueryLegs = append(query.QueryLegs, newQueryLeg(s.Destination, s.Origin, *req.ReturnDate))
+		}
+
+		resp, errResp := Search(ctx, c, &CreateRequest{Query: &query})
+		if resp != nil {
+			s.SessionToken = resp.SessionToken
+			s.Status = resp.Status
+		}
+		if errResp != nil {
+			s.Error = errResp
+			return
+		}
+		responses[i] = resp
+	})
+
+	var lastErr *ErrorResponse
+	succeeded := 0
+	for i := range sessions {
+		switch {
+		case responses[i] != nil:
+			succeeded++
+		case sessions[i].Error == nil:
+			sessions[i].Error = internalErrorResponse("search was not started: " + ctx.Err().Error())
+			lastErr = sessions[i].Error
+		default:
+			lastErr = sessions[i].Error
+		}
+	}
+	if succeeded == 0 {
+		return nil, lastErr
+	}
+
+	return &FanOutSearchResponse{
+		MergedResults: MergeResults(responses...),
+		Sessions:      sessions,
+	}, nil
+}
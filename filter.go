@@ -0,0 +1,287 @@
+package skyscanner
+
+import (
+	"sort"
+	"time"
+)
+
+// ItineraryFilter decides whether the itinerary should be kept
+type ItineraryFilter func(r *Results, it ItineraryResult) bool
+
+// PricingOptionFilter decides whether the pricing option matches
+type PricingOptionFilter func(r *Results, po PricingOption) bool
+
+// TimeWindow is a time of day range with inclusive bounds.
+// The bounds are offsets from midnight, e.g. 6*time.Hour.
+// If From is after To, the window wraps midnight
+type TimeWindow struct {
+	From time.Duration
+	To   time.Duration
+}
+
+// Contains reports whether the time of day is inside the window
+func (w TimeWindow) Contains(d time.Duration) bool {
+	if w.From <= w.To {
+		return d >= w.From && d <= w.To
+	}
+
+	return d >= w.From || d <= w.To
+}
+
+// FilterResult contains IDs of the kept itineraries and stats recomputed for them
+type FilterResult struct {
+	ItineraryIDs []string `json:"itineraryIds"`
+	Stats        *Stats   `json:"stats"`
+}
+
+// Filter returns IDs of itineraries matching all the filters, sorted in ascending order,
+// and stats computed for them
+func (r *Results) Filter(filters ...ItineraryFilter) *FilterResult {
+	keep := FilterAll(filters...)
+
+	ids := make([]string, 0, len(r.Itineraries))
+	for id, it := range r.Itineraries {
+		if keep(r, it) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	return &FilterResult{
+		ItineraryIDs: ids,
+		Stats:        r.SubsetStats(ids),
+	}
+}
+
+// FilterAll matches itineraries matching all the filters
+func FilterAll(filters ...ItineraryFilter) ItineraryFilter {
+	return func(r *Results, it ItineraryResult) bool {
+		for _, f := range filters {
+			if !f(r, it) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// FilterAny matches itineraries matching at least one of the filters
+func FilterAny(filters ...ItineraryFilter) ItineraryFilter {
+	return func(r *Results, it ItineraryResult) bool {
+		for _, f := range filters {
+			if f(r, it) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// FilterNot inverts the filter
+func FilterNot(f ItineraryFilter) ItineraryFilter {
+	return func(r *Results, it ItineraryResult) bool {
+		return !f(r, it)
+	}
+}
+
+// FilterMaxStops matches itineraries where every leg has at most n stops
+func FilterMaxStops(n int32) ItineraryFilter {
+	return func(r *Results, it ItineraryResult) bool {
+		return r.ItineraryStops(it) <= n
+	}
+}
+
+// FilterMaxDuration matches itineraries with total legs duration of at most the given minutes
+func FilterMaxDuration(minutes int32) ItineraryFilter {
+	return func(r *Results, it ItineraryResult) bool {
+		return r.ItineraryDuration(it) <= minutes
+	}
+}
+
+// FilterDepartureWindow matches itineraries whose leg with the given index departs inside the window.
+// Itineraries without such leg are kept
+func FilterDepartureWindow(legIndex int, w TimeWindow) ItineraryFilter {
+	return func(r *Results, it ItineraryResult) bool {
+		legs := r.ItineraryLegs(it)
+		if legIndex < 0 || legIndex >= len(legs) {
+			return true
+		}
+
+		return w.Contains(legs[legIndex].DepartureDateTime.TimeOfDay())
+	}
+}
+
+// FilterArrivalWindow matches itineraries whose leg with the given index arrives inside the window.
+// Itineraries without such leg are kept
+func FilterArrivalWindow(legIndex int, w TimeWindow) ItineraryFilter {
+	return func(r *Results, it ItineraryResult) bool {
+		legs := r.ItineraryLegs(it)
+		if legIndex < 0 || legIndex >= len(legs) {
+			return true
+		}
+
+		return w.Contains(legs[legIndex].ArrivalDateTime.TimeOfDay())
+	}
+}
+
+// FilterIncludedCarriers matches itineraries operated only by the given marketing carriers
+func FilterIncludedCarriers(carrierIDs ...string) ItineraryFilter {
+	set := stringSet(carrierIDs)
+	return func(r *Results, it ItineraryResult) bool {
+		for _, id := range r.ItineraryCarrierIDs(it) {
+			if _, ok := set[id]; !ok {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// FilterExcludedCarriers matches itineraries without any of the given marketing carriers
+func FilterExcludedCarriers(carrierIDs ...string) ItineraryFilter {
+	set := stringSet(carrierIDs)
+	return func(r *Results, it ItineraryResult) bool {
+		for _, id := range r.ItineraryCarrierIDs(it) {
+			if _, ok := set[id]; ok {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// FilterIncludedAlliances matches itineraries where every marketing carrier belongs to one of the given alliances
+func FilterIncludedAlliances(allianceIDs ...string) ItineraryFilter {
+	set := stringSet(allianceIDs)
+	return func(r *Results, it ItineraryResult) bool {
+		for _, id := range r.ItineraryCarrierIDs(it) {
+			if _, ok := set[r.Carriers[id].AllianceID]; !ok {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// FilterExcludedAlliances matches itineraries without carriers of the given alliances
+func FilterExcludedAlliances(allianceIDs ...string) ItineraryFilter {
+	set := stringSet(allianceIDs)
+	return func(r *Results, it ItineraryResult) bool {
+		for _, id := range r.ItineraryCarrierIDs(it) {
+			if _, ok := set[r.Carriers[id].AllianceID]; ok {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// FilterNoAirportChange matches itineraries without airport changes during connections
+func FilterNoAirportChange() ItineraryFilter {
+	return func(r *Results, it ItineraryResult) bool {
+		return !r.ItineraryHasAirportChange(it)
+	}
+}
+
+// FilterEcoContenders matches eco contender itineraries only
+func FilterEcoContenders() ItineraryFilter {
+	return func(r *Results, it ItineraryResult) bool {
+		return it.SustainabilityData.IsEcoContender
+	}
+}
+
+// FilterPricingOptions matches itineraries having at least one pricing option matching all the filters
+func FilterPricingOptions(filters ...PricingOptionFilter) ItineraryFilter {
+	return func(r *Results, it ItineraryResult) bool {
+	options:
+		for _, po := range it.PricingOptions {
+			for _, f := range filters {
+				if !f(r, po) {
+					continue options
+				}
+			}
+
+			return true
+		}
+
+		return false
+	}
+}
+
+// OptionTransferTypes matches pricing options with one of the given transfer types
+func OptionTransferTypes(types ...TransferType) PricingOptionFilter {
+	return func(r *Results, po PricingOption) bool {
+		for _, t := range types {
+			if po.TransferType == t {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// OptionAgentTypes matches pricing options sold only by agents of the given types
+func OptionAgentTypes(types ...AgentType) PricingOptionFilter {
+	return func(r *Results, po PricingOption) bool {
+	agents:
+		for _, id := range po.AgentIds {
+			for _, t := range types {
+				if r.Agents[id].Type == t {
+					continue agents
+				}
+			}
+
+			return false
+		}
+
+		return true
+	}
+}
+
+// OptionMinAgentRating matches pricing options sold only by agents rated at least minRating.
+// Unknown agents do not match
+func OptionMinAgentRating(minRating float32) PricingOptionFilter {
+	return func(r *Results, po PricingOption) bool {
+		for _, id := range po.AgentIds {
+			a, ok := r.Agents[id]
+			if !ok || a.Rating < minRating {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// OptionPriceRange matches pricing options priced between min and max in major currency units.
+// A zero max means no upper bound. Options without a price do not match
+func OptionPriceRange(min, max float64) PricingOptionFilter {
+	return func(r *Results, po PricingOption) bool {
+		if po.Price.Amount == "" {
+			return false
+		}
+
+		p, err := po.Price.ToFloat()
+		if err != nil {
+			return false
+		}
+
+		return p >= min && (max == 0 || p <= max)
+	}
+}
+
+func stringSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+
+	return set
+}
@@ -0,0 +1,189 @@
+package skyscanner
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testResults returns three itineraries from London to New York:
+//   - "direct" is a non-stop oneworld flight for 500
+//   - "onestop" is a Star Alliance flight with a connection in Frankfurt for 300
+//   - "change" is a low-cost flight changing the airport in Milan for 200
+func testResults() *Results {
+	at := func(hour, minute int32) LocalDatetime {
+		return LocalDatetime{Year: 2024, Month: 10, Day: 10, Hour: hour, Minute: minute}
+	}
+	price := func(amount string) Price {
+		return Price{Amount: amount, Unit: PriceUnitMilli}
+	}
+
+	return &Results{
+		Itineraries: map[string]ItineraryResult{
+			"direct": {
+				LegIds: []string{"leg-direct"},
+				PricingOptions: []PricingOption{
+					{Price: price("500000"), AgentIds: []string{"ba"}, TransferType: TransferTypeManaged},
+				},
+			},
+			"onestop": {
+				LegIds: []string{"leg-onestop"},
+				PricingOptions: []PricingOption{
+					{Price: price("300000"), AgentIds: []string{"ota"}, TransferType: TransferTypeSelfTransfer},
+				},
+				SustainabilityData: SustainabilityData{IsEcoContender: true, EcoContenderDelta: 12},
+			},
+			"change": {
+				LegIds: []string{"leg-change"},
+				PricingOptions: []PricingOption{
+					{AgentIds: []string{"ba"}, TransferType: TransferTypeManaged},
+					{Price: price("200000"), AgentIds: []string{"gone"}, TransferType: TransferTypeProtectedSelfTransfer},
+				},
+			},
+		},
+		Legs: map[string]FlightLeg{
+			"leg-direct": {
+				OriginPlaceID: "lhr", DestinationPlaceID: "jfk",
+				DepartureDateTime: at(7, 0), ArrivalDateTime: at(10, 0),
+				DurationInMinutes: 420, MarketingCarrierIds: []string{"ba"},
+				SegmentIds: []string{"lhr-jfk"},
+			},
+			"leg-onestop": {
+				OriginPlaceID: "lhr", DestinationPlaceID: "jfk",
+				DepartureDateTime: at(23, 30), ArrivalDateTime: at(9, 30),
+				DurationInMinutes: 600, StopCount: 1, MarketingCarrierIds: []string{"lh"},
+				SegmentIds: []string{"lhr-fra", "fra-jfk"},
+			},
+			"leg-change": {
+				OriginPlaceID: "stn", DestinationPlaceID: "jfk",
+				DepartureDateTime: at(5, 0), ArrivalDateTime: at(14, 0),
+				DurationInMinutes: 540, StopCount: 1, MarketingCarrierIds: []string{"fr"},
+				SegmentIds: []string{"stn-bgy", "mxp-jfk"},
+			},
+		},
+		Segments: map[string]Segment{
+			"lhr-jfk": {OriginPlaceID: "lhr", DestinationPlaceID: "jfk", DepartureDateTime: at(7, 0), ArrivalDateTime: at(10, 0)},
+			"lhr-fra": {OriginPlaceID: "lhr", DestinationPlaceID: "fra", DepartureDateTime: at(23, 30), ArrivalDateTime: at(2, 0)},
+			"fra-jfk": {OriginPlaceID: "fra", DestinationPlaceID: "jfk", DepartureDateTime: at(3, 30), ArrivalDateTime: at(9, 30)},
+			"stn-bgy": {OriginPlaceID: "stn", DestinationPlaceID: "bgy", DepartureDateTime: at(5, 0), ArrivalDateTime: at(8, 0)},
+			"mxp-jfk": {OriginPlaceID: "mxp", DestinationPlaceID: "jfk", DepartureDateTime: at(10, 30), ArrivalDateTime: at(14, 0)},
+		},
+		Places: map[string]Place{
+			"lhr": {IATA: "LHR", Name: "London Heathrow"},
+			"stn": {IATA: "STN", Name: "London Stansted"},
+			"fra": {IATA: "FRA", Name: "Frankfurt"},
+			"bgy": {IATA: "BGY", Name: "Milan Bergamo"},
+			"mxp": {IATA: "MXP", Name: "Milan Malpensa"},
+			"jfk": {IATA: "JFK", Name: "New York John F. Kennedy"},
+		},
+		Carriers: map[string]Carrier{
+			"ba": {Name: "British Airways", AllianceID: "oneworld"},
+			"lh": {Name: "Lufthansa", AllianceID: "star"},
+			"fr": {Name: "Ryanair"},
+		},
+		Agents: map[string]Agent{
+			"ba":  {Name: "British Airways", Type: AgentTypeAirline, Rating: 4.5},
+			"ota": {Name: "Travel agent", Type: AgentTypeTravelAgent, Rating: 3},
+		},
+	}
+}
+
+func TestTimeWindowContains(t *testing.T) {
+	day := TimeWindow{From: 6 * time.Hour, To: 12 * time.Hour}
+	night := TimeWindow{From: 22 * time.Hour, To: 6 * time.Hour}
+
+	tests := []struct {
+		name   string
+		window TimeWindow
+		at     time.Duration
+		want   bool
+	}{
+		{name: "inside", window: day, at: 9 * time.Hour, want: true},
+		{name: "from bound", window: day, at: 6 * time.Hour, want: true},
+		{name: "to bound", window: day, at: 12 * time.Hour, want: true},
+		{name: "before", window: day, at: 5*time.Hour + 59*time.Minute, want: false},
+		{name: "after", window: day, at: 12*time.Hour + time.Second, want: false},
+		{name: "wrapping before midnight", window: night, at: 23 * time.Hour, want: true},
+		{name: "wrapping after midnight", window: night, at: time.Hour, want: true},
+		{name: "wrapping midnight", window: night, at: 0, want: true},
+		{name: "outside wrapping", window: night, at: 12 * time.Hour, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Contains(tt.at); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResultsFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters []ItineraryFilter
+		want    []string
+	}{
+		{name: "no filters", want: []string{"change", "direct", "onestop"}},
+		{name: "max stops", filters: []ItineraryFilter{FilterMaxStops(0)}, want: []string{"direct"}},
+		{name: "max duration", filters: []ItineraryFilter{FilterMaxDuration(540)}, want: []string{"change", "direct"}},
+		{
+			name:    "departure window",
+			filters: []ItineraryFilter{FilterDepartureWindow(0, TimeWindow{From: 6 * time.Hour, To: 12 * time.Hour})},
+			want:    []string{"direct"},
+		},
+		{
+			name:    "departure window wrapping midnight",
+			filters: []ItineraryFilter{FilterDepartureWindow(0, TimeWindow{From: 22 * time.Hour, To: 6 * time.Hour})},
+			want:    []string{"change", "onestop"},
+		},
+		{
+			name:    "arrival window of a missing leg",
+			filters: []ItineraryFilter{FilterArrivalWindow(1, TimeWindow{From: 0, To: time.Hour})},
+			want:    []string{"change", "direct", "onestop"},
+		},
+		{
+			name:    "negative leg index",
+			filters: []ItineraryFilter{FilterArrivalWindow(-1, TimeWindow{From: 0, To: time.Hour})},
+			want:    []string{"change", "direct", "onestop"},
+		},
+		{name: "included carriers", filters: []ItineraryFilter{FilterIncludedCarriers("ba", "lh")}, want: []string{"direct", "onestop"}},
+		{name: "excluded carriers", filters: []ItineraryFilter{FilterExcludedCarriers("fr")}, want: []string{"direct", "onestop"}},
+		{name: "included alliances drop carriers without alliance", filters: []ItineraryFilter{FilterIncludedAlliances("oneworld", "star")}, want: []string{"direct", "onestop"}},
+		{name: "excluded alliances", filters: []ItineraryFilter{FilterExcludedAlliances("star")}, want: []string{"change", "direct"}},
+		{name: "no airport change", filters: []ItineraryFilter{FilterNoAirportChange()}, want: []string{"direct", "onestop"}},
+		{name: "eco contenders", filters: []ItineraryFilter{FilterEcoContenders()}, want: []string{"onestop"}},
+		{name: "any", filters: []ItineraryFilter{FilterAny(FilterMaxStops(0), FilterEcoContenders())}, want: []string{"direct", "onestop"}},
+		{name: "any without filters", filters: []ItineraryFilter{FilterAny()}, want: []string{}},
+		{name: "not", filters: []ItineraryFilter{FilterNot(FilterMaxStops(0))}, want: []string{"change", "onestop"}},
+		{name: "all filters", filters: []ItineraryFilter{FilterMaxStops(1), FilterExcludedCarriers("ba"), FilterNoAirportChange()}, want: []string{"onestop"}},
+		{
+			name:    "transfer types",
+			filters: []ItineraryFilter{FilterPricingOptions(OptionTransferTypes(TransferTypeSelfTransfer, TransferTypeProtectedSelfTransfer))},
+			want:    []string{"change", "onestop"},
+		},
+		{name: "agent types", filters: []ItineraryFilter{FilterPricingOptions(OptionAgentTypes(AgentTypeAirline))}, want: []string{"change", "direct"}},
+		{name: "min agent rating", filters: []ItineraryFilter{FilterPricingOptions(OptionMinAgentRating(4))}, want: []string{"change", "direct"}},
+		{name: "price range without upper bound", filters: []ItineraryFilter{FilterPricingOptions(OptionPriceRange(250, 0))}, want: []string{"direct", "onestop"}},
+		{name: "price range skips unpriced options", filters: []ItineraryFilter{FilterPricingOptions(OptionPriceRange(0, 250))}, want: []string{"change"}},
+		{
+			// the rated option of "change" has no price and the priced one is sold by an unknown agent
+			name:    "option filters match the same option",
+			filters: []ItineraryFilter{FilterPricingOptions(OptionMinAgentRating(4), OptionPriceRange(0, 0))},
+			want:    []string{"direct"},
+		},
+	}
+
+	r := testResults()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := r.Filter(tt.filters...)
+			if !reflect.DeepEqual(got.ItineraryIDs, tt.want) {
+				t.Errorf("got %v, want %v", got.ItineraryIDs, tt.want)
+			}
+			if count := got.Stats.Itineraries.Total.Count; count != int32(len(tt.want)) {
+				t.Errorf("stats count %d itineraries, want %d", count, len(tt.want))
+			}
+		})
+	}
+}
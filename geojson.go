@@ -0,0 +1,270 @@
+package skyscanner
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const greatCircleStepKm = 100
+
+// FeatureCollection is an RFC 7946 GeoJSON feature collection
+type FeatureCollection struct {
+	Type     string     `json:"type"`
+	Features []*Feature `json:"features"`
+}
+
+// Feature is a GeoJSON feature
+type Feature struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id,omitempty"`
+	Geometry   Geometry               `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// Geometry is a GeoJSON geometry. Coordinates are [longitude, latitude] positions
+type Geometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+// ParseLocation parses the "latitude,longitude" location string returned by autosuggest
+func ParseLocation(location string) (float64, float64, error) {
+	parts := strings.Split(location, ",")
+	if len(parts) != 2 {
+		return 0, 0, errors.New("invalid location " + location)
+	}
+
+	lat, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return 0, 0, errors.New("invalid location latitude: " + err.Error())
+	}
+	lon, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return 0, 0, errors.New("invalid location longitude: " + err.Error())
+	}
+
+	return lat, lon, nil
+}
+
+// AddSuggestions adds airports with locations from the autosuggest response to the table.
+// Existing time zones are kept since autosuggest does not return them
+func (t AirportTable) AddSuggestions(resp *AutoSuggestFlightsResponse) {
+	add := func(iata, location string) {
+		if iata == "" || location == "" {
+			return
+		}
+		lat, lon, err := ParseLocation(location)
+		if err != nil {
+			return
+		}
+
+		a := t[iata]
+		a.IATA, a.Latitude, a.Longitude = iata, lat, lon
+		t[iata] = a
+	}
+
+	for _, p := range resp.Places {
+		if p.Type == PlaceTypeAirport {
+			add(p.IATACode, p.Location)
+		}
+		add(p.AirportInformation.IATACode, p.AirportInformation.Location)
+	}
+}
+
+// GeoJSON returns Point features for every place with known coordinates
+// and great-circle LineString features for every segment between them.
+// Segment features carry the cheapest price of the itineraries flying them
+func (r *Results) GeoJSON(airports AirportTable) *FeatureCollection {
+	minPrices := make(map[string]float64)
+	for _, it := range r.Itineraries {
+		po, price := it.CheapestPricingOption()
+		if po == nil {
+			continue
+		}
+
+		for _, leg := range r.ItineraryLegs(it) {
+			for _, id := range leg.SegmentIds {
+				if current, ok := minPrices[id]; !ok || price < current {
+					minPrices[id] = price
+				}
+			}
+		}
+	}
+
+	fc := newFeatureCollection()
+	for _, id := range sortedKeys(r.Places, nil) {
+		if f := placeFeature(id, r.Places[id], airports); f != nil {
+			fc.Features = append(fc.Features, f)
+		}
+	}
+
+	segmentIDs := sortedKeys(r.Segments, nil)
+	for _, id := range segmentIDs {
+		s := r.Segments[id]
+		f := segmentFeature(id, r.Places[s.OriginPlaceID], r.Places[s.DestinationPlaceID], airports)
+		if f == nil {
+			continue
+		}
+
+		f.Properties["carrier"] = r.Carriers[s.MarketingCarrierId].Name
+		f.Properties["carrierIata"] = r.Carriers[s.MarketingCarrierId].IATA
+		f.Properties["flightNumber"] = r.Carriers[s.MarketingCarrierId].IATA + s.MarketingFlightNumber
+		f.Properties["durationInMinutes"] = s.DurationInMinutes
+		if price, ok := minPrices[id]; ok {
+			f.Properties["minPrice"] = price
+		}
+		fc.Features = append(fc.Features, f)
+	}
+
+	return fc
+}
+
+// ItineraryGeoJSON returns Point features for the itinerary airports and
+// great-circle LineString features for its segments.
+// Segment features carry the itinerary cheapest price
+func ItineraryGeoJSON(ri *ResolvedItinerary, airports AirportTable) *FeatureCollection {
+	price, hasPrice := 0.0, false
+	if po, p := (ItineraryResult{PricingOptions: ri.PricingOptions}).CheapestPricingOption(); po != nil {
+		price, hasPrice = p, true
+	}
+
+	fc := newFeatureCollection()
+	seen := make(map[string]struct{})
+	for _, leg := range ri.Legs {
+		for _, s := range leg.Segments {
+			for _, p := range []Place{s.Origin, s.Destination} {
+				if _, ok := seen[p.EntityId]; ok {
+					continue
+				}
+				seen[p.EntityId] = struct{}{}
+				if f := placeFeature(p.EntityId, p, airports); f != nil {
+					fc.Features = append(fc.Features, f)
+				}
+			}
+
+			f := segmentFeature(s.ID, s.Origin, s.Destination, airports)
+			if f == nil {
+				continue
+			}
+
+			f.Properties["legId"] = leg.ID
+			f.Properties["carrier"] = s.MarketingCarrier.Name
+			f.Properties["carrierIata"] = s.MarketingCarrier.IATA
+			f.Properties["flightNumber"] = s.MarketingCarrier.IATA + s.MarketingFlightNumber
+			f.Properties["durationInMinutes"] = s.DurationInMinutes
+			if hasPrice {
+				f.Properties["price"] = price
+			}
+			fc.Features = append(fc.Features, f)
+		}
+	}
+
+	return fc
+}
+
+func newFeatureCollection() *FeatureCollection {
+	return &FeatureCollection{Type: "FeatureCollection", Features: make([]*Feature, 0)}
+}
+
+func placeFeature(id string, p Place, airports AirportTable) *Feature {
+	a, ok := airports.Lookup(placeIATA(p))
+	if !ok {
+		return nil
+	}
+
+	return &Feature{
+		Type:     "Feature",
+		ID:       id,
+		Geometry: Geometry{Type: "Point", Coordinates: []float64{a.Longitude, a.Latitude}},
+		Properties: map[string]interface{}{
+			"kind": "place",
+			"name": p.Name,
+			"iata": p.IATA,
+			"type": p.Type,
+		},
+	}
+}
+
+func segmentFeature(id string, origin, destination Place, airports AirportTable) *Feature {
+	from, ok := airports.Lookup(placeIATA(origin))
+	if !ok {
+		return nil
+	}
+	to, ok := airports.Lookup(placeIATA(destination))
+	if !ok {
+		return nil
+	}
+
+	return &Feature{
+		Type:     "Feature",
+		ID:       id,
+		Geometry: greatCircle(from, to),
+		Properties: map[string]interface{}{
+			"kind":        "segment",
+			"origin":      placeIATA(origin),
+			"destination": placeIATA(destination),
+			"distanceKm":  GreatCircleDistance(from, to),
+		},
+	}
+}
+
+// greatCircle returns a LineString following the great circle between the airports.
+// Lines crossing the antimeridian are split into a MultiLineString as RFC 7946 recommends
+func greatCircle(from, to Airport) Geometry {
+	distance := GreatCircleDistance(from, to)
+	steps := int(math.Ceil(distance / greatCircleStepKm))
+	if steps < 1 {
+		steps = 1
+	}
+
+	if distance == 0 {
+		p := []float64{from.Longitude, from.Latitude}
+		return Geometry{Type: "LineString", Coordinates: [][]float64{p, p}}
+	}
+
+	lat1, lon1 := radians(from.Latitude), radians(from.Longitude)
+	lat2, lon2 := radians(to.Latitude), radians(to.Longitude)
+	d := distance / earthRadiusKm
+
+	points := make([][]float64, 0, steps+1)
+	for i := 0; i <= steps; i++ {
+		f := float64(i) / float64(steps)
+		a := math.Sin((1-f)*d) / math.Sin(d)
+		b := math.Sin(f*d) / math.Sin(d)
+		x := a*math.Cos(lat1)*math.Cos(lon1) + b*math.Cos(lat2)*math.Cos(lon2)
+		y := a*math.Cos(lat1)*math.Sin(lon1) + b*math.Cos(lat2)*math.Sin(lon2)
+		z := a*math.Sin(lat1) + b*math.Sin(lat2)
+
+		lat := math.Atan2(z, math.Sqrt(x*x+y*y)) * 180 / math.Pi
+		lon := math.Atan2(y, x) * 180 / math.Pi
+		points = append(points, []float64{lon, lat})
+	}
+
+	lines := [][][]float64{{points[0]}}
+	for i := 1; i < len(points); i++ {
+		prev, cur := points[i-1], points[i]
+		if math.Abs(cur[0]-prev[0]) <= 180 {
+			lines[len(lines)-1] = append(lines[len(lines)-1], cur)
+			continue
+		}
+
+		// Interpolate the latitude where the line crosses the antimeridian
+		edge := 180.0
+		if prev[0] < 0 {
+			edge = -180
+		}
+		curLon := cur[0] + 2*edge
+		lat := prev[1] + (cur[1]-prev[1])*(edge-prev[0])/(curLon-prev[0])
+
+		lines[len(lines)-1] = append(lines[len(lines)-1], []float64{edge, lat})
+		lines = append(lines, [][]float64{{-edge, lat}, cur})
+	}
+
+	if len(lines) == 1 {
+		return Geometry{Type: "LineString", Coordinates: lines[0]}
+	}
+
+	return Geometry{Type: "MultiLineString", Coordinates: lines}
+}
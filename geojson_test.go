@@ -0,0 +1,157 @@
+package skyscanner
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestParseLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		location string
+		wantLat  float64
+		wantLon  float64
+		wantErr  bool
+	}{
+		{name: "valid", location: "51.47, -0.4543", wantLat: 51.47, wantLon: -0.4543},
+		{name: "missing longitude", location: "51.47", wantErr: true},
+		{name: "too many parts", location: "51.47,-0.45,0", wantErr: true},
+		{name: "invalid latitude", location: "north,-0.45", wantErr: true},
+		{name: "invalid longitude", location: "51.47,west", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lat, lon, err := ParseLocation(tt.location)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if lat != tt.wantLat || lon != tt.wantLon {
+				t.Errorf("got %v,%v, want %v,%v", lat, lon, tt.wantLat, tt.wantLon)
+			}
+		})
+	}
+}
+
+func TestAirportTableAddSuggestions(t *testing.T) {
+	airports := AirportTable{"LHR": {IATA: "LHR", Timezone: "Europe/London"}}
+	airports.AddSuggestions(&AutoSuggestFlightsResponse{Places: []*AutoSuggestPlace{
+		{IATACode: "LHR", Type: PlaceTypeAirport, Location: "51.47,-0.4543"},
+		{IATACode: "LON", Type: PlaceTypeCity, Location: "51.5,-0.12"},
+		{IATACode: "LGW", Type: PlaceTypeAirport, Location: "invalid"},
+		{IATACode: "LON", Type: PlaceTypeCity, AirportInformation: AirportInformation{IATACode: "STN", Location: "51.885,0.235"}},
+	}})
+
+	want := AirportTable{
+		"LHR": {IATA: "LHR", Latitude: 51.47, Longitude: -0.4543, Timezone: "Europe/London"},
+		"STN": {IATA: "STN", Latitude: 51.885, Longitude: 0.235},
+	}
+	if !reflect.DeepEqual(airports, want) {
+		t.Errorf("got %+v, want %+v", airports, want)
+	}
+}
+
+func TestGreatCircle(t *testing.T) {
+	airports := DefaultAirports()
+	lhr, _ := airports.Lookup("LHR")
+	jfk, _ := airports.Lookup("JFK")
+	nrt, _ := airports.Lookup("NRT")
+	sfo, _ := airports.Lookup("SFO")
+
+	t.Run("line string", func(t *testing.T) {
+		g := greatCircle(lhr, jfk)
+		points, ok := g.Coordinates.([][]float64)
+		if g.Type != "LineString" || !ok {
+			t.Fatalf("got %s geometry, want LineString", g.Type)
+		}
+		checkPosition(t, points[0], lhr)
+		checkPosition(t, points[len(points)-1], jfk)
+		if want := int(math.Ceil(GreatCircleDistance(lhr, jfk)/greatCircleStepKm)) + 1; len(points) != want {
+			t.Errorf("got %d points, want %d", len(points), want)
+		}
+	})
+
+	t.Run("same airport", func(t *testing.T) {
+		g := greatCircle(lhr, lhr)
+		want := [][]float64{{lhr.Longitude, lhr.Latitude}, {lhr.Longitude, lhr.Latitude}}
+		if g.Type != "LineString" || !reflect.DeepEqual(g.Coordinates, want) {
+			t.Errorf("got %s %v, want a LineString of two equal points", g.Type, g.Coordinates)
+		}
+	})
+
+	t.Run("antimeridian", func(t *testing.T) {
+		g := greatCircle(nrt, sfo)
+		lines, ok := g.Coordinates.([][][]float64)
+		if g.Type != "MultiLineString" || !ok || len(lines) != 2 {
+			t.Fatalf("got %s %v, want a MultiLineString of two lines", g.Type, g.Coordinates)
+		}
+
+		first, second := lines[0], lines[1]
+		checkPosition(t, first[0], nrt)
+		checkPosition(t, second[len(second)-1], sfo)
+		end, start := first[len(first)-1], second[0]
+		if end[0] != 180 || start[0] != -180 || end[1] != start[1] {
+			t.Errorf("lines are split at %v and %v, want the same point on the antimeridian", end, start)
+		}
+	})
+}
+
+func checkPosition(t *testing.T, p []float64, a Airport) {
+	t.Helper()
+
+	if math.Abs(p[0]-a.Longitude) > 1e-9 || math.Abs(p[1]-a.Latitude) > 1e-9 {
+		t.Errorf("got position %v, want %s at [%v %v]", p, a.IATA, a.Longitude, a.Latitude)
+	}
+}
+
+func TestResultsGeoJSON(t *testing.T) {
+	r := testResults()
+	r.Segments["lhr-jfk"] = Segment{OriginPlaceID: "lhr", DestinationPlaceID: "jfk", MarketingCarrierId: "ba", MarketingFlightNumber: "117", DurationInMinutes: 420}
+	r.Carriers["ba"] = Carrier{Name: "British Airways", IATA: "BA"}
+
+	airports := AirportTable{}
+	for _, iata := range []string{"LHR", "FRA", "JFK"} {
+		airports[iata], _ = DefaultAirports().Lookup(iata)
+	}
+
+	fc := r.GeoJSON(airports)
+	if fc.Type != "FeatureCollection" {
+		t.Errorf("got %s, want FeatureCollection", fc.Type)
+	}
+
+	var ids []string
+	for _, f := range fc.Features {
+		ids = append(ids, f.ID)
+	}
+	// places and segments between airports missing in the table are skipped
+	wantIDs := []string{"fra", "jfk", "lhr", "fra-jfk", "lhr-fra", "lhr-jfk"}
+	if !reflect.DeepEqual(ids, wantIDs) {
+		t.Fatalf("got features %v, want %v", ids, wantIDs)
+	}
+
+	tests := []struct {
+		id   string
+		want map[string]interface{}
+	}{
+		{id: "lhr", want: map[string]interface{}{"kind": "place", "name": "London Heathrow", "iata": "LHR", "type": PlaceType("")}},
+		{id: "fra-jfk", want: map[string]interface{}{"kind": "segment", "origin": "FRA", "destination": "JFK", "minPrice": 300.0}},
+		{id: "lhr-jfk", want: map[string]interface{}{
+			"kind": "segment", "origin": "LHR", "destination": "JFK", "minPrice": 500.0,
+			"carrier": "British Airways", "carrierIata": "BA", "flightNumber": "BA117", "durationInMinutes": int32(420),
+		}},
+	}
+	for _, tt := range tests {
+		var f *Feature
+		for _, feature := range fc.Features {
+			if feature.ID == tt.id {
+				f = feature
+			}
+		}
+		for k, v := range tt.want {
+			if f.Properties[k] != v {
+				t.Errorf("%s property %s is %v, want %v", tt.id, k, f.Properties[k], v)
+			}
+		}
+	}
+}
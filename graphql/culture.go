@@ -0,0 +1,49 @@
+package graphql
+
+import "github.com/VitaliyJ/skyscanner"
+
+type suggestedPlaceResolver struct {
+	p skyscanner.AutoSuggestPlace
+}
+
+func (r *suggestedPlaceResolver) EntityId() string    { return r.p.EntityId }
+func (r *suggestedPlaceResolver) IataCode() string    { return r.p.IATACode }
+func (r *suggestedPlaceResolver) Name() string        { return r.p.Name }
+func (r *suggestedPlaceResolver) Type() string        { return string(r.p.Type) }
+func (r *suggestedPlaceResolver) CityName() string    { return r.p.CityName }
+func (r *suggestedPlaceResolver) CountryName() string { return r.p.CountryName }
+func (r *suggestedPlaceResolver) Location() string    { return r.p.Location }
+
+type localeResolver struct {
+	l skyscanner.Locale
+}
+
+func (r *localeResolver) Code() string { return r.l.Code }
+func (r *localeResolver) Name() string { return r.l.Name }
+
+type marketResolver struct {
+	m skyscanner.Market
+}
+
+func (r *marketResolver) Code() string { return r.m.Code }
+func (r *marketResolver) Name() string { return r.m.Name }
+
+type currencyResolver struct {
+	c skyscanner.Currency
+}
+
+func (r *currencyResolver) Code() string                      { return r.c.Code }
+func (r *currencyResolver) Symbol() string                    { return r.c.Symbol }
+func (r *currencyResolver) ThousandsSeparator() string        { return r.c.ThousandsSeparator }
+func (r *currencyResolver) DecimalSeparator() string          { return r.c.DecimalSeparator }
+func (r *currencyResolver) SymbolOnLeft() bool                { return r.c.SymbolOnLeft }
+func (r *currencyResolver) SpaceBetweenAmountAndSymbol() bool { return r.c.SpaceBetweenAmountAndSymbol }
+func (r *currencyResolver) DecimalDigits() int32              { return r.c.DecimalDigits }
+
+type cultureResolver struct {
+	c *skyscanner.NearestCultureResponse
+}
+
+func (r *cultureResolver) Market() *marketResolver     { return &marketResolver{m: r.c.Market} }
+func (r *cultureResolver) Locale() *localeResolver     { return &localeResolver{l: r.c.Locale} }
+func (r *cultureResolver) Currency() *currencyResolver { return &currencyResolver{c: r.c.Currency} }
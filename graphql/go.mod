@@ -0,0 +1,10 @@
+module github.com/VitaliyJ/skyscanner/graphql
+
+go 1.21
+
+require (
+	github.com/VitaliyJ/skyscanner v0.0.0
+	github.com/graph-gophers/graphql-go v1.5.0
+)
+
+replace github.com/VitaliyJ/skyscanner => ../
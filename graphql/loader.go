@@ -0,0 +1,153 @@
+package graphql
+
+import (
+	"sync"
+
+	"github.com/VitaliyJ/skyscanner"
+)
+
+// loader resolves ID references from the results maps.
+// Referenced objects are resolved once per search and shared by all the fields referencing them,
+// so lists of IDs are resolved in a single pass without repeated lookups
+type loader struct {
+	results *skyscanner.Results
+
+	mu        sync.Mutex
+	places    map[string]*placeResolver
+	carriers  map[string]*carrierResolver
+	agents    map[string]*agentResolver
+	legs      map[string]*legResolver
+	segments  map[string]*segmentResolver
+	alliances map[string]*allianceResolver
+}
+
+func newLoader(r *skyscanner.Results) *loader {
+	return &loader{
+		results:   r,
+		places:    make(map[string]*placeResolver),
+		carriers:  make(map[string]*carrierResolver),
+		agents:    make(map[string]*agentResolver),
+		legs:      make(map[string]*legResolver),
+		segments:  make(map[string]*segmentResolver),
+		alliances: make(map[string]*allianceResolver),
+	}
+}
+
+// load returns cached resolvers of the IDs, creating missing ones with newFn.
+// Unknown IDs are skipped
+func load[T any](l *loader, cache map[string]*T, ids []string, newFn func(id string) *T) []*T {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	resolved := make([]*T, 0, len(ids))
+	for _, id := range ids {
+		v, ok := cache[id]
+		if !ok {
+			v = newFn(id)
+			cache[id] = v
+		}
+		if v != nil {
+			resolved = append(resolved, v)
+		}
+	}
+
+	return resolved
+}
+
+// loadOne returns the resolver of the ID or nil if the ID is unknown
+func loadOne[T any](l *loader, cache map[string]*T, id string, newFn func(id string) *T) *T {
+	if id == "" {
+		return nil
+	}
+	if resolved := load(l, cache, []string{id}, newFn); len(resolved) > 0 {
+		return resolved[0]
+	}
+
+	return nil
+}
+
+func (l *loader) place(id string) *placeResolver {
+	return loadOne(l, l.places, id, l.newPlace)
+}
+
+func (l *loader) carrier(id string) *carrierResolver {
+	return loadOne(l, l.carriers, id, l.newCarrier)
+}
+
+func (l *loader) carrierList(ids []string) []*carrierResolver {
+	return load(l, l.carriers, ids, l.newCarrier)
+}
+
+func (l *loader) agent(id string) *agentResolver {
+	return loadOne(l, l.agents, id, l.newAgent)
+}
+
+func (l *loader) agentList(ids []string) []*agentResolver {
+	return load(l, l.agents, ids, l.newAgent)
+}
+
+func (l *loader) alliance(id string) *allianceResolver {
+	return loadOne(l, l.alliances, id, l.newAlliance)
+}
+
+func (l *loader) legList(ids []string) []*legResolver {
+	return load(l, l.legs, ids, l.newLeg)
+}
+
+func (l *loader) segmentList(ids []string) []*segmentResolver {
+	return load(l, l.segments, ids, l.newSegment)
+}
+
+func (l *loader) newPlace(id string) *placeResolver {
+	p, ok := l.results.Places[id]
+	if !ok {
+		return nil
+	}
+
+	return &placeResolver{l: l, id: id, p: p}
+}
+
+func (l *loader) newCarrier(id string) *carrierResolver {
+	c, ok := l.results.Carriers[id]
+	if !ok {
+		return nil
+	}
+
+	return &carrierResolver{l: l, id: id, c: c}
+}
+
+func (l *loader) newAgent(id string) *agentResolver {
+	a, ok := l.results.Agents[id]
+	if !ok {
+		return nil
+	}
+
+	return &agentResolver{id: id, a: a}
+}
+
+func (l *loader) newAlliance(id string) *allianceResolver {
+	a, ok := l.results.Alliances[id]
+	if !ok {
+		return nil
+	}
+
+	return &allianceResolver{id: id, a: a}
+}
+
+func (l *loader) newLeg(id string) *legResolver {
+	leg, ok := l.results.Legs[id]
+	if !ok {
+		return nil
+	}
+
+	return &legResolver{l: l, id: id, leg: leg}
+}
+
+func (l *loader) newSegment(id string) *segmentResolver {
+	s, ok := l.results.Segments[id]
+	if !ok {
+		return nil
+	}
+
+	return &segmentResolver{l: l, id: id, s: s}
+}
@@ -0,0 +1,181 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/VitaliyJ/skyscanner"
+)
+
+const (
+	defaultMarket   = "UK"
+	defaultLocale   = "en-GB"
+	defaultCurrency = "GBP"
+)
+
+// Resolver is the root Query resolver
+type Resolver struct {
+	client skyscanner.Client
+}
+
+// NewResolver returns the root resolver using the client
+func NewResolver(c skyscanner.Client) *Resolver {
+	return &Resolver{client: c}
+}
+
+// SearchInput is the search query input
+type SearchInput struct {
+	Origin       string
+	Destination  string
+	Date         string
+	ReturnDate   *string
+	Adults       *int32
+	ChildrenAges *[]int32
+	CabinClass   *string
+	Market       *string
+	Locale       *string
+	Currency     *string
+}
+
+// Search runs the search until it is complete
+func (r *Resolver) Search(ctx context.Context, args struct{ Input SearchInput }) (*SearchResolver, error) {
+	req, err := args.Input.request()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, errResp := skyscanner.Search(ctx, r.client, req)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	return newSearchResolver(resp), nil
+}
+
+// Autosuggest returns places matching the term
+func (r *Resolver) Autosuggest(ctx context.Context, args struct {
+	Term        string
+	Market      *string
+	Locale      *string
+	Limit       *int32
+	Destination *bool
+}) ([]*suggestedPlaceResolver, error) {
+	req := &skyscanner.AutoSuggestFlightsRequest{
+		Query: skyscanner.AutoSuggestFlightsRequestQuery{
+			Market:     valueOr(args.Market, defaultMarket),
+			Locale:     valueOr(args.Locale, defaultLocale),
+			SearchTerm: args.Term,
+		},
+	}
+	if args.Limit != nil {
+		req.Limit = *args.Limit
+	}
+	if args.Destination != nil {
+		req.IsDestination = *args.Destination
+	}
+
+	resp, errResp := r.client.AutoSuggestFlights(ctx, req)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	places := make([]*suggestedPlaceResolver, 0, len(resp.Places))
+	for _, p := range resp.Places {
+		if p != nil {
+			places = append(places, &suggestedPlaceResolver{p: *p})
+		}
+	}
+
+	return places, nil
+}
+
+// Locales returns the supported locales
+func (r *Resolver) Locales(ctx context.Context) ([]*localeResolver, error) {
+	resp, errResp := r.client.Locales(ctx)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	locales := make([]*localeResolver, 0, len(resp.Locales))
+	for _, l := range resp.Locales {
+		locales = append(locales, &localeResolver{l: l})
+	}
+
+	return locales, nil
+}
+
+// Currencies returns the supported currencies
+func (r *Resolver) Currencies(ctx context.Context) ([]*currencyResolver, error) {
+	resp, errResp := r.client.Currencies(ctx)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	currencies := make([]*currencyResolver, 0, len(resp.Currencies))
+	for _, c := range resp.Currencies {
+		currencies = append(currencies, &currencyResolver{c: c})
+	}
+
+	return currencies, nil
+}
+
+// Markets returns the supported markets
+func (r *Resolver) Markets(ctx context.Context, args struct{ Locale *string }) ([]*marketResolver, error) {
+	resp, errResp := r.client.Markets(ctx, valueOr(args.Locale, defaultLocale))
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	markets := make([]*marketResolver, 0, len(resp.Markets))
+	for _, m := range resp.Markets {
+		markets = append(markets, &marketResolver{m: m})
+	}
+
+	return markets, nil
+}
+
+// NearestCulture returns the culture nearest to the IP address
+func (r *Resolver) NearestCulture(ctx context.Context, args struct{ IP string }) (*cultureResolver, error) {
+	resp, errResp := r.client.NearestCulture(ctx, args.IP)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	return &cultureResolver{c: resp}, nil
+}
+
+func (in SearchInput) request() (*skyscanner.CreateRequest, error) {
+	returnDate := ""
+	if in.ReturnDate != nil {
+		returnDate = *in.ReturnDate
+	}
+	req, err := skyscanner.NewCreateRequest(in.Origin, in.Destination, in.Date, returnDate)
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.Query
+	q.Market = valueOr(in.Market, defaultMarket)
+	q.Locale = valueOr(in.Locale, defaultLocale)
+	q.Currency = valueOr(in.Currency, defaultCurrency)
+	if in.Adults != nil {
+		q.Adults = *in.Adults
+	}
+	if in.CabinClass != nil {
+		q.CabinClass = skyscanner.CabinClass(*in.CabinClass)
+	}
+	if in.ChildrenAges != nil {
+		for _, age := range *in.ChildrenAges {
+			q.ChildrenAges = append(q.ChildrenAges, int(age))
+		}
+	}
+
+	return req, nil
+}
+
+func valueOr(v *string, def string) string {
+	if v == nil || *v == "" {
+		return def
+	}
+
+	return *v
+}
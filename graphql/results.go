@@ -0,0 +1,367 @@
+package graphql
+
+import (
+	"errors"
+
+	"github.com/VitaliyJ/skyscanner"
+)
+
+const timeLayout = "2006-01-02T15:04:05"
+
+// ItineraryFilterInput is the itinerary filter input
+type ItineraryFilterInput struct {
+	MaxStops          *int32
+	MaxDuration       *int32
+	IncludedCarriers  *[]string
+	ExcludedCarriers  *[]string
+	IncludedAlliances *[]string
+	ExcludedAlliances *[]string
+	NoAirportChange   *bool
+	EcoContendersOnly *bool
+	TransferTypes     *[]string
+	MinPrice          *float64
+	MaxPrice          *float64
+}
+
+// SearchResolver resolves the search results graph
+type SearchResolver struct {
+	resp    *skyscanner.CreatePollResponse
+	results *skyscanner.Results
+	l       *loader
+}
+
+func newSearchResolver(resp *skyscanner.CreatePollResponse) *SearchResolver {
+	r := &skyscanner.Results{}
+	if resp.Content != nil && resp.Content.Results != nil {
+		r = resp.Content.Results
+	}
+
+	return &SearchResolver{resp: resp, results: r, l: newLoader(r)}
+}
+
+func (r *SearchResolver) Status() string {
+	return string(r.resp.Status)
+}
+
+func (r *SearchResolver) Stats(args struct{ Filter *ItineraryFilterInput }) *statsResolver {
+	return &statsResolver{s: r.results.Filter(args.Filter.filters()...).Stats}
+}
+
+func (r *SearchResolver) Itineraries(args struct {
+	Filter *ItineraryFilterInput
+	Sort   string
+	First  int32
+	Offset int32
+}) ([]*itineraryResolver, error) {
+	if args.First < 0 || args.Offset < 0 {
+		return nil, errors.New("first and offset must not be negative")
+	}
+
+	ids := r.results.Filter(args.Filter.filters()...).ItineraryIDs
+	if err := r.sort(ids, args.Sort); err != nil {
+		return nil, err
+	}
+
+	if int(args.Offset) >= len(ids) {
+		return []*itineraryResolver{}, nil
+	}
+	ids = ids[args.Offset:]
+	if len(ids) > int(args.First) {
+		ids = ids[:args.First]
+	}
+
+	its := make([]*itineraryResolver, 0, len(ids))
+	for _, id := range ids {
+		its = append(its, r.itinerary(id))
+	}
+
+	return its, nil
+}
+
+func (r *SearchResolver) Itinerary(args struct{ ID string }) *itineraryResolver {
+	return r.itinerary(args.ID)
+}
+
+func (r *SearchResolver) itinerary(id string) *itineraryResolver {
+	it, ok := r.results.Itineraries[id]
+	if !ok {
+		return nil
+	}
+
+	return &itineraryResolver{l: r.l, id: id, it: it}
+}
+
+func (r *SearchResolver) sort(ids []string, order string) error {
+	var less skyscanner.ItineraryLess
+	switch order {
+	case "", "BEST":
+		for i, item := range r.results.Rank(ids, skyscanner.DefaultWeightedScorer) {
+			ids[i] = item.ItineraryID
+		}
+		return nil
+	case "CHEAPEST":
+		less = skyscanner.SortCheapest
+	case "FASTEST":
+		less = skyscanner.SortFastest
+	case "EARLIEST_DEPARTURE":
+		less = skyscanner.SortEarliestDeparture
+	case "LATEST_DEPARTURE":
+		less = skyscanner.SortLatestDeparture
+	case "FEWEST_STOPS":
+		less = skyscanner.SortFewestStops
+	case "GREENEST":
+		less = skyscanner.SortGreenest
+	default:
+		return errors.New("unknown sort " + order)
+	}
+
+	r.results.SortItineraries(ids, less)
+
+	return nil
+}
+
+// filters converts the input to SDK filters. Nil input matches all itineraries
+func (in *ItineraryFilterInput) filters() []skyscanner.ItineraryFilter {
+	if in == nil {
+		return nil
+	}
+
+	var filters []skyscanner.ItineraryFilter
+	if in.MaxStops != nil {
+		filters = append(filters, skyscanner.FilterMaxStops(*in.MaxStops))
+	}
+	if in.MaxDuration != nil {
+		filters = append(filters, skyscanner.FilterMaxDuration(*in.MaxDuration))
+	}
+	if in.IncludedCarriers != nil {
+		filters = append(filters, skyscanner.FilterIncludedCarriers(*in.IncludedCarriers...))
+	}
+	if in.ExcludedCarriers != nil {
+		filters = append(filters, skyscanner.FilterExcludedCarriers(*in.ExcludedCarriers...))
+	}
+	if in.IncludedAlliances != nil {
+		filters = append(filters, skyscanner.FilterIncludedAlliances(*in.IncludedAlliances...))
+	}
+	if in.ExcludedAlliances != nil {
+		filters = append(filters, skyscanner.FilterExcludedAlliances(*in.ExcludedAlliances...))
+	}
+	if in.NoAirportChange != nil && *in.NoAirportChange {
+		filters = append(filters, skyscanner.FilterNoAirportChange())
+	}
+	if in.EcoContendersOnly != nil && *in.EcoContendersOnly {
+		filters = append(filters, skyscanner.FilterEcoContenders())
+	}
+
+	var options []skyscanner.PricingOptionFilter
+	if in.TransferTypes != nil {
+		types := make([]skyscanner.TransferType, 0, len(*in.TransferTypes))
+		for _, t := range *in.TransferTypes {
+			types = append(types, skyscanner.TransferType(t))
+		}
+		options = append(options, skyscanner.OptionTransferTypes(types...))
+	}
+	if in.MinPrice != nil || in.MaxPrice != nil {
+		min, max := 0.0, 0.0
+		if in.MinPrice != nil {
+			min = *in.MinPrice
+		}
+		if in.MaxPrice != nil {
+			max = *in.MaxPrice
+		}
+		options = append(options, skyscanner.OptionPriceRange(min, max))
+	}
+	if len(options) > 0 {
+		filters = append(filters, skyscanner.FilterPricingOptions(options...))
+	}
+
+	return filters
+}
+
+type statsResolver struct {
+	s *skyscanner.Stats
+}
+
+func (r *statsResolver) MinDuration() int32 { return r.s.Itineraries.MinDuration }
+func (r *statsResolver) MaxDuration() int32 { return r.s.Itineraries.MaxDuration }
+func (r *statsResolver) Total() *summaryResolver {
+	return &summaryResolver{s: r.s.Itineraries.Total}
+}
+func (r *statsResolver) Direct() *summaryResolver {
+	return &summaryResolver{s: r.s.Itineraries.Stops.Direct.Total}
+}
+func (r *statsResolver) OneStop() *summaryResolver {
+	return &summaryResolver{s: r.s.Itineraries.Stops.OneStop.Total}
+}
+func (r *statsResolver) TwoPlusStops() *summaryResolver {
+	return &summaryResolver{s: r.s.Itineraries.Stops.TwoPlusStops.Total}
+}
+func (r *statsResolver) HasChangeAirportTransfer() bool {
+	return r.s.Itineraries.HasChangeAirportTransfer
+}
+
+type summaryResolver struct {
+	s skyscanner.ItinerarySummary
+}
+
+func (r *summaryResolver) Count() int32 { return r.s.Count }
+func (r *summaryResolver) MinPrice() *float64 {
+	return priceValue(r.s.MinPrice)
+}
+
+type itineraryResolver struct {
+	l  *loader
+	id string
+	it skyscanner.ItineraryResult
+}
+
+func (r *itineraryResolver) ID() string { return r.id }
+func (r *itineraryResolver) Price() *float64 {
+	po, price := r.it.CheapestPricingOption()
+	if po == nil {
+		return nil
+	}
+
+	return &price
+}
+func (r *itineraryResolver) PricingOptions() []*pricingOptionResolver {
+	options := make([]*pricingOptionResolver, 0, len(r.it.PricingOptions))
+	for _, po := range r.it.PricingOptions {
+		options = append(options, &pricingOptionResolver{l: r.l, po: po})
+	}
+
+	return options
+}
+func (r *itineraryResolver) Legs() []*legResolver     { return r.l.legList(r.it.LegIds) }
+func (r *itineraryResolver) DurationInMinutes() int32 { return r.l.results.ItineraryDuration(r.it) }
+func (r *itineraryResolver) Stops() int32             { return r.l.results.ItineraryStops(r.it) }
+func (r *itineraryResolver) IsEcoContender() bool     { return r.it.SustainabilityData.IsEcoContender }
+func (r *itineraryResolver) EcoContenderDelta() float64 {
+	return float64(r.it.SustainabilityData.EcoContenderDelta)
+}
+
+type pricingOptionResolver struct {
+	l  *loader
+	po skyscanner.PricingOption
+}
+
+func (r *pricingOptionResolver) Price() *float64          { return priceValue(r.po.Price) }
+func (r *pricingOptionResolver) TransferType() string     { return string(r.po.TransferType) }
+func (r *pricingOptionResolver) Agents() []*agentResolver { return r.l.agentList(r.po.AgentIds) }
+func (r *pricingOptionResolver) Items() []*pricingItemResolver {
+	items := make([]*pricingItemResolver, 0, len(r.po.Items))
+	for _, item := range r.po.Items {
+		items = append(items, &pricingItemResolver{l: r.l, item: item})
+	}
+
+	return items
+}
+
+type pricingItemResolver struct {
+	l    *loader
+	item skyscanner.LivePricingOptionItem
+}
+
+func (r *pricingItemResolver) Price() *float64       { return priceValue(r.item.Price) }
+func (r *pricingItemResolver) Agent() *agentResolver { return r.l.agent(r.item.AgentID) }
+func (r *pricingItemResolver) DeepLink() string      { return r.item.DeepLink }
+
+type legResolver struct {
+	l   *loader
+	id  string
+	leg skyscanner.FlightLeg
+}
+
+func (r *legResolver) ID() string                  { return r.id }
+func (r *legResolver) Origin() *placeResolver      { return r.l.place(r.leg.OriginPlaceID) }
+func (r *legResolver) Destination() *placeResolver { return r.l.place(r.leg.DestinationPlaceID) }
+func (r *legResolver) Departure() string           { return r.leg.DepartureDateTime.Time().Format(timeLayout) }
+func (r *legResolver) Arrival() string             { return r.leg.ArrivalDateTime.Time().Format(timeLayout) }
+func (r *legResolver) DurationInMinutes() int32    { return r.leg.DurationInMinutes }
+func (r *legResolver) StopCount() int32            { return r.leg.StopCount }
+func (r *legResolver) MarketingCarriers() []*carrierResolver {
+	return r.l.carrierList(r.leg.MarketingCarrierIds)
+}
+func (r *legResolver) OperatingCarriers() []*carrierResolver {
+	return r.l.carrierList(r.leg.OperatingCarrierIds)
+}
+func (r *legResolver) Segments() []*segmentResolver { return r.l.segmentList(r.leg.SegmentIds) }
+
+type segmentResolver struct {
+	l  *loader
+	id string
+	s  skyscanner.Segment
+}
+
+func (r *segmentResolver) ID() string                  { return r.id }
+func (r *segmentResolver) Origin() *placeResolver      { return r.l.place(r.s.OriginPlaceID) }
+func (r *segmentResolver) Destination() *placeResolver { return r.l.place(r.s.DestinationPlaceID) }
+func (r *segmentResolver) Departure() string           { return r.s.DepartureDateTime.Time().Format(timeLayout) }
+func (r *segmentResolver) Arrival() string             { return r.s.ArrivalDateTime.Time().Format(timeLayout) }
+func (r *segmentResolver) DurationInMinutes() int32    { return r.s.DurationInMinutes }
+func (r *segmentResolver) FlightNumber() string {
+	return r.l.results.Carriers[r.s.MarketingCarrierId].IATA + r.s.MarketingFlightNumber
+}
+func (r *segmentResolver) MarketingCarrier() *carrierResolver {
+	return r.l.carrier(r.s.MarketingCarrierId)
+}
+func (r *segmentResolver) OperatingCarrier() *carrierResolver {
+	return r.l.carrier(r.s.OperatingCarrierId)
+}
+
+type placeResolver struct {
+	l  *loader
+	id string
+	p  skyscanner.Place
+}
+
+func (r *placeResolver) ID() string             { return r.id }
+func (r *placeResolver) Parent() *placeResolver { return r.l.place(r.p.ParentId) }
+func (r *placeResolver) Name() string           { return r.p.Name }
+func (r *placeResolver) Type() string           { return string(r.p.Type) }
+func (r *placeResolver) Iata() string           { return r.p.IATA }
+
+type carrierResolver struct {
+	l  *loader
+	id string
+	c  skyscanner.Carrier
+}
+
+func (r *carrierResolver) ID() string                  { return r.id }
+func (r *carrierResolver) Name() string                { return r.c.Name }
+func (r *carrierResolver) Iata() string                { return r.c.IATA }
+func (r *carrierResolver) ImageUrl() string            { return r.c.ImageURL }
+func (r *carrierResolver) Alliance() *allianceResolver { return r.l.alliance(r.c.AllianceID) }
+
+type allianceResolver struct {
+	id string
+	a  skyscanner.Alliance
+}
+
+func (r *allianceResolver) ID() string   { return r.id }
+func (r *allianceResolver) Name() string { return r.a.Name }
+
+type agentResolver struct {
+	id string
+	a  skyscanner.Agent
+}
+
+func (r *agentResolver) ID() string                 { return r.id }
+func (r *agentResolver) Name() string               { return r.a.Name }
+func (r *agentResolver) Type() string               { return string(r.a.Type) }
+func (r *agentResolver) ImageUrl() string           { return r.a.ImageURL }
+func (r *agentResolver) Rating() float64            { return float64(r.a.Rating) }
+func (r *agentResolver) FeedbackCount() int32       { return r.a.FeedbackCount }
+func (r *agentResolver) IsOptimisedForMobile() bool { return r.a.IsOptimisedForMobile }
+
+// priceValue returns the price in major currency units or nil if it is unknown
+func priceValue(p skyscanner.Price) *float64 {
+	if p.Amount == "" {
+		return nil
+	}
+	v, err := p.ToFloat()
+	if err != nil {
+		return nil
+	}
+
+	return &v
+}
@@ -0,0 +1,222 @@
+// Package graphql provides a GraphQL schema and resolvers over the SDK.
+//
+// Resolvers follow the github.com/graph-gophers/graphql-go conventions and depend on the SDK and the standard library only,
+// so the schema can be served with:
+//
+//	schema := graphql.MustParseSchema(skyscannergraphql.Schema, skyscannergraphql.NewResolver(client))
+//	http.Handle("/graphql", &relay.Handler{Schema: schema})
+//
+// It is a separate module, so the SDK does not depend on GraphQL libraries
+package graphql
+
+// Schema is the GraphQL schema served by Resolver
+const Schema = `
+schema {
+	query: Query
+}
+
+type Query {
+	# Runs a live search until it is complete
+	search(input: SearchInput!): Search!
+	autosuggest(term: String!, market: String, locale: String, limit: Int, destination: Boolean): [SuggestedPlace!]!
+	locales: [Locale!]!
+	currencies: [Currency!]!
+	markets(locale: String): [Market!]!
+	nearestCulture(ip: String!): Culture!
+}
+
+input SearchInput {
+	# IATA codes or entity IDs
+	origin: String!
+	destination: String!
+	# Dates in the 2006-01-02 format
+	date: String!
+	returnDate: String
+	adults: Int
+	childrenAges: [Int!]
+	cabinClass: CabinClass
+	market: String
+	locale: String
+	currency: String
+}
+
+input ItineraryFilter {
+	maxStops: Int
+	maxDuration: Int
+	includedCarriers: [String!]
+	excludedCarriers: [String!]
+	includedAlliances: [String!]
+	excludedAlliances: [String!]
+	noAirportChange: Boolean
+	ecoContendersOnly: Boolean
+	transferTypes: [TransferType!]
+	minPrice: Float
+	maxPrice: Float
+}
+
+enum ItinerarySort {
+	BEST
+	CHEAPEST
+	FASTEST
+	EARLIEST_DEPARTURE
+	LATEST_DEPARTURE
+	FEWEST_STOPS
+	GREENEST
+}
+
+enum CabinClass {
+	CABIN_CLASS_UNSPECIFIED
+	CABIN_CLASS_ECONOMY
+	CABIN_CLASS_PREMIUM_ECONOMY
+	CABIN_CLASS_BUSINESS
+	CABIN_CLASS_FIRST
+}
+
+enum TransferType {
+	TRANSFER_TYPE_UNSPECIFIED
+	TRANSFER_TYPE_MANAGED
+	TRANSFER_TYPE_SELF_TRANSFER
+	TRANSFER_TYPE_PROTECTED_SELF_TRANSFER
+}
+
+type Search {
+	status: String!
+	# Stats of the itineraries matching the filter
+	stats(filter: ItineraryFilter): Stats!
+	itineraries(filter: ItineraryFilter, sort: ItinerarySort = BEST, first: Int = 20, offset: Int = 0): [Itinerary!]!
+	itinerary(id: String!): Itinerary
+}
+
+type Stats {
+	minDuration: Int!
+	maxDuration: Int!
+	total: Summary!
+	direct: Summary!
+	oneStop: Summary!
+	twoPlusStops: Summary!
+	hasChangeAirportTransfer: Boolean!
+}
+
+type Summary {
+	count: Int!
+	minPrice: Float
+}
+
+type Itinerary {
+	id: String!
+	# Price of the cheapest pricing option in major currency units
+	price: Float
+	pricingOptions: [PricingOption!]!
+	legs: [Leg!]!
+	durationInMinutes: Int!
+	stops: Int!
+	isEcoContender: Boolean!
+	ecoContenderDelta: Float!
+}
+
+type PricingOption {
+	price: Float
+	transferType: TransferType!
+	agents: [Agent!]!
+	items: [PricingItem!]!
+}
+
+type PricingItem {
+	price: Float
+	agent: Agent
+	deepLink: String!
+}
+
+type Leg {
+	id: String!
+	origin: Place
+	destination: Place
+	# Local airport times in the 2006-01-02T15:04:05 format
+	departure: String!
+	arrival: String!
+	durationInMinutes: Int!
+	stopCount: Int!
+	marketingCarriers: [Carrier!]!
+	operatingCarriers: [Carrier!]!
+	segments: [Segment!]!
+}
+
+type Segment {
+	id: String!
+	origin: Place
+	destination: Place
+	departure: String!
+	arrival: String!
+	durationInMinutes: Int!
+	flightNumber: String!
+	marketingCarrier: Carrier
+	operatingCarrier: Carrier
+}
+
+type Place {
+	id: String!
+	parent: Place
+	name: String!
+	type: String!
+	iata: String!
+}
+
+type Carrier {
+	id: String!
+	name: String!
+	iata: String!
+	imageUrl: String!
+	alliance: Alliance
+}
+
+type Alliance {
+	id: String!
+	name: String!
+}
+
+type Agent {
+	id: String!
+	name: String!
+	type: String!
+	imageUrl: String!
+	rating: Float!
+	feedbackCount: Int!
+	isOptimisedForMobile: Boolean!
+}
+
+type SuggestedPlace {
+	entityId: String!
+	iataCode: String!
+	name: String!
+	type: String!
+	cityName: String!
+	countryName: String!
+	location: String!
+}
+
+type Locale {
+	code: String!
+	name: String!
+}
+
+type Market {
+	code: String!
+	name: String!
+}
+
+type Currency {
+	code: String!
+	symbol: String!
+	thousandsSeparator: String!
+	decimalSeparator: String!
+	symbolOnLeft: Boolean!
+	spaceBetweenAmountAndSymbol: Boolean!
+	decimalDigits: Int!
+}
+
+type Culture {
+	market: Market!
+	locale: Locale!
+	currency: Currency!
+}
+`
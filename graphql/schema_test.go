@@ -0,0 +1,43 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	gql "github.com/graph-gophers/graphql-go"
+
+	"github.com/VitaliyJ/skyscanner"
+)
+
+// cultureClient serves the culture endpoints only
+type cultureClient struct {
+	skyscanner.Client
+}
+
+func (cultureClient) Locales(context.Context) (*skyscanner.LocalesResponse, *skyscanner.ErrorResponse) {
+	return &skyscanner.LocalesResponse{
+		Status:  skyscanner.ResponseStatusComplete,
+		Locales: []skyscanner.Locale{{Code: "en-GB", Name: "English (United Kingdom)"}},
+	}, nil
+}
+
+func TestSchemaMatchesResolver(t *testing.T) {
+	// MustParseSchema panics if any schema field has no matching resolver method
+	schema := gql.MustParseSchema(Schema, NewResolver(cultureClient{}))
+
+	resp := schema.Exec(context.Background(), `{ locales { code name } }`, "", nil)
+	if len(resp.Errors) != 0 {
+		t.Fatal(resp.Errors)
+	}
+
+	var got struct {
+		Locales []struct{ Code, Name string }
+	}
+	if err := json.Unmarshal(resp.Data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Locales) != 1 || got.Locales[0].Code != "en-GB" {
+		t.Errorf("unexpected locales %s", resp.Data)
+	}
+}
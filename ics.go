@@ -0,0 +1,294 @@
+package skyscanner
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	icsLocalLayout  = "20060102T150405"
+	icsUTCLayout    = "20060102T150405Z"
+	icsLineLimit    = 75
+	defaultICSProd  = "-//VitaliyJ//skyscanner//EN"
+	defaultICSUIDAt = "skyscanner"
+)
+
+// ICalendarOptions contains iCalendar export options
+type ICalendarOptions struct {
+	// Airports resolve time zones of the airports. Default is DefaultAirports().
+	// Times of airports missing in the table or with unknown time zones are exported as floating local times.
+	// Time zones are loaded from the host zoneinfo. On hosts without it import time/tzdata in the main package
+	// or build with -tags timetzdata
+	Airports AirportTable
+	// IncludeLayovers adds an event for every connection between segments
+	IncludeLayovers bool
+	// ProdID is the calendar PRODID. Default is "-//VitaliyJ//skyscanner//EN"
+	ProdID string
+	// UIDDomain is appended to event UIDs. Default is "skyscanner"
+	UIDDomain string
+	// Now is used for DTSTAMP. Default is time.Now()
+	Now time.Time
+}
+
+// icsTime is an event time in a known location or a floating local time
+type icsTime struct {
+	local LocalDatetime
+	loc   *time.Location
+}
+
+// WriteICalendar writes the itinerary as an RFC 5545 iCalendar document with an event per segment.
+// Times carry the TZID of the airport with a VTIMEZONE definition for the period they fall in
+func WriteICalendar(w io.Writer, ri *ResolvedItinerary, opts ICalendarOptions) error {
+	if opts.Airports == nil {
+		opts.Airports = DefaultAirports()
+	}
+	if opts.ProdID == "" {
+		opts.ProdID = defaultICSProd
+	}
+	if opts.UIDDomain == "" {
+		opts.UIDDomain = defaultICSUIDAt
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	var events [][]string
+	zones := make(map[string]map[int64]icsZonePeriod)
+	at := func(p Place, d LocalDatetime) icsTime {
+		t := icsTime{local: d}
+		a, ok := opts.Airports.Lookup(placeIATA(p))
+		if !ok || a.Timezone == "" {
+			return t
+		}
+		loc, err := time.LoadLocation(a.Timezone)
+		if err != nil {
+			return t
+		}
+
+		t.loc = loc
+		period := newICSZonePeriod(t.time())
+		if zones[loc.String()] == nil {
+			zones[loc.String()] = make(map[int64]icsZonePeriod)
+		}
+		zones[loc.String()][period.start.Unix()] = period
+
+		return t
+	}
+
+	stamp := opts.Now.UTC().Format(icsUTCLayout)
+	for _, leg := range ri.Legs {
+		for i, s := range leg.Segments {
+			flight := s.MarketingCarrier.IATA + s.MarketingFlightNumber
+			description := []string{
+				"Flight " + flight + " " + s.MarketingCarrier.Name,
+				"From: " + placeTitle(s.Origin),
+				"To: " + placeTitle(s.Destination),
+			}
+			if s.OperatingCarrier.Name != "" && s.OperatingCarrier.Name != s.MarketingCarrier.Name {
+				description = append(description, "Operated by "+s.OperatingCarrier.Name)
+			}
+
+			events = append(events, icsEvent(
+				icsUID(ri.ID, s.ID, opts.UIDDomain),
+				stamp,
+				at(s.Origin, s.DepartureDateTime),
+				at(s.Destination, s.ArrivalDateTime),
+				"Flight "+flight+" "+placeIATA(s.Origin)+" → "+placeIATA(s.Destination),
+				placeTitle(s.Origin),
+				strings.Join(description, "\n"),
+				false,
+			))
+
+			if !opts.IncludeLayovers || i == len(leg.Segments)-1 {
+				continue
+			}
+
+			next := leg.Segments[i+1]
+			summary := "Layover in " + placeTitle(s.Destination)
+			if placeIATA(s.Destination) != placeIATA(next.Origin) {
+				summary = "Layover: change airport " + placeIATA(s.Destination) + " → " + placeIATA(next.Origin)
+			}
+			events = append(events, icsEvent(
+				icsUID(ri.ID, s.ID+"-"+next.ID, opts.UIDDomain),
+				stamp,
+				at(s.Destination, s.ArrivalDateTime),
+				at(next.Origin, next.DepartureDateTime),
+				summary,
+				placeTitle(s.Destination),
+				"Next flight "+next.MarketingCarrier.IATA+next.MarketingFlightNumber+" from "+placeTitle(next.Origin),
+				true,
+			))
+		}
+	}
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:" + opts.ProdID,
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+	}
+
+	tzids := make([]string, 0, len(zones))
+	for tzid := range zones {
+		tzids = append(tzids, tzid)
+	}
+	sort.Strings(tzids)
+	for _, tzid := range tzids {
+		lines = append(lines, icsTimezone(tzid, zones[tzid])...)
+	}
+
+	for _, e := range events {
+		lines = append(lines, e...)
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	bw := bufio.NewWriter(w)
+	for _, l := range lines {
+		if _, err := bw.WriteString(icsFold(l)); err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+func icsEvent(uid, stamp string, start, end icsTime, summary, location, description string, transparent bool) []string {
+	lines := []string{
+		"BEGIN:VEVENT",
+		"UID:" + uid,
+		"DTSTAMP:" + stamp,
+		"DTSTART" + start.property(),
+		"DTEND" + end.property(),
+		"SUMMARY:" + icsEscape(summary),
+		"LOCATION:" + icsEscape(location),
+		"DESCRIPTION:" + icsEscape(description),
+	}
+	if transparent {
+		lines = append(lines, "TRANSP:TRANSPARENT")
+	}
+
+	return append(lines, "END:VEVENT")
+}
+
+// property returns the time property parameters and value, e.g. ";TZID=Europe/London:20221103T090000"
+func (t icsTime) property() string {
+	value := t.local.Time().Format(icsLocalLayout)
+	if t.loc == nil {
+		return ":" + value
+	}
+
+	return ";TZID=" + t.loc.String() + ":" + value
+}
+
+func (t icsTime) time() time.Time {
+	d := t.local
+	return time.Date(int(d.Year), time.Month(d.Month), int(d.Day), int(d.Hour), int(d.Minute), int(d.Second), 0, t.loc)
+}
+
+// icsZonePeriod is a period of a time zone with a constant offset
+type icsZonePeriod struct {
+	start      time.Time
+	name       string
+	offset     int
+	offsetFrom int
+	dst        bool
+}
+
+func newICSZonePeriod(t time.Time) icsZonePeriod {
+	name, offset := t.Zone()
+	start, _ := t.ZoneBounds()
+	p := icsZonePeriod{start: start, name: name, offset: offset, offsetFrom: offset, dst: t.IsDST()}
+	if start.IsZero() {
+		return p
+	}
+
+	_, p.offsetFrom = start.Add(-time.Second).Zone()
+
+	return p
+}
+
+// icsTimezone returns VTIMEZONE with an observance for every period the events fall in
+func icsTimezone(tzid string, periods map[int64]icsZonePeriod) []string {
+	starts := make([]int64, 0, len(periods))
+	for s := range periods {
+		starts = append(starts, s)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+
+	lines := []string{"BEGIN:VTIMEZONE", "TZID:" + tzid}
+	for _, s := range starts {
+		p := periods[s]
+		kind := "STANDARD"
+		if p.dst {
+			kind = "DAYLIGHT"
+		}
+
+		// Observance onset is expressed in the local time before the transition
+		onset := "19700101T000000"
+		if !p.start.IsZero() {
+			onset = p.start.In(time.FixedZone("", p.offsetFrom)).Format(icsLocalLayout)
+		}
+
+		lines = append(lines,
+			"BEGIN:"+kind,
+			"DTSTART:"+onset,
+			"TZOFFSETFROM:"+icsOffset(p.offsetFrom),
+			"TZOFFSETTO:"+icsOffset(p.offset),
+			"TZNAME:"+icsEscape(p.name),
+			"END:"+kind,
+		)
+	}
+
+	return append(lines, "END:VTIMEZONE")
+}
+
+func icsOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+
+	return sign + time.Date(0, 1, 1, 0, 0, seconds, 0, time.UTC).Format("1504")
+}
+
+func icsUID(itineraryID, eventID, domain string) string {
+	replacer := strings.NewReplacer(" ", "", "|", "-", "#", "-")
+	return replacer.Replace(itineraryID+"-"+eventID) + "@" + domain
+}
+
+func icsEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// icsFold splits the content line into lines of at most 75 octets and terminates it with CRLF
+func icsFold(line string) string {
+	b := strings.Builder{}
+	width := 0
+	for _, r := range line {
+		size := utf8.RuneLen(r)
+		if width+size > icsLineLimit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	b.WriteString("\r\n")
+
+	return b.String()
+}
+
+func placeTitle(p Place) string {
+	code := placeIATA(p)
+	if p.Name == "" {
+		return code
+	}
+
+	return p.Name + " (" + code + ")"
+}
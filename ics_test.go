@@ -0,0 +1,140 @@
+package skyscanner
+
+import (
+	"strings"
+	"testing"
+	"time"
+	// the tests do not depend on the host zoneinfo
+	_ "time/tzdata"
+)
+
+func TestWriteICalendar(t *testing.T) {
+	at := func(day, hour int32) LocalDatetime {
+		return LocalDatetime{Year: 2024, Month: 10, Day: day, Hour: hour}
+	}
+	lhr := Place{IATA: "LHR", Name: "London Heathrow"}
+	fra := Place{IATA: "FRA", Name: "Frankfurt"}
+	xxx := Place{IATA: "XXX"}
+	ba := Carrier{IATA: "BA", Name: "British Airways"}
+	lh := Carrier{IATA: "LH", Name: "Lufthansa"}
+
+	// the first flight lands after Central Europe switches to the winter time
+	ri := &ResolvedItinerary{
+		ID: "13554-2410262200--32480-1-9999-2410270900",
+		Legs: []ResolvedLeg{{Segments: []ResolvedSegment{
+			{
+				ID: "s1", Origin: lhr, Destination: fra, DepartureDateTime: at(26, 22), ArrivalDateTime: at(27, 4),
+				MarketingFlightNumber: "902", MarketingCarrier: ba, OperatingCarrier: lh,
+			},
+			{
+				ID: "s2", Origin: fra, Destination: xxx, DepartureDateTime: at(27, 6), ArrivalDateTime: at(27, 9),
+				MarketingFlightNumber: "100", MarketingCarrier: lh, OperatingCarrier: lh,
+			},
+		}}},
+	}
+	airports := AirportTable{
+		"LHR": {IATA: "LHR", Timezone: "Europe/London"},
+		"FRA": {IATA: "FRA", Timezone: "Europe/Berlin"},
+	}
+
+	b := strings.Builder{}
+	err := WriteICalendar(&b, ri, ICalendarOptions{
+		Airports:        airports,
+		IncludeLayovers: true,
+		Now:             time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := b.String()
+	if !strings.HasSuffix(out, "END:VCALENDAR\r\n") {
+		t.Errorf("calendar is not terminated: %q", out)
+	}
+	for _, l := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		if len(l) > icsLineLimit {
+			t.Errorf("line %q is longer than %d octets", l, icsLineLimit)
+		}
+	}
+
+	unfolded := strings.ReplaceAll(out, "\r\n ", "")
+	for _, want := range []string{
+		"PRODID:-//VitaliyJ//skyscanner//EN",
+		strings.Join([]string{
+			"BEGIN:VTIMEZONE", "TZID:Europe/Berlin",
+			"BEGIN:STANDARD", "DTSTART:20241027T030000", "TZOFFSETFROM:+0200", "TZOFFSETTO:+0100", "TZNAME:CET", "END:STANDARD",
+			"END:VTIMEZONE",
+			"BEGIN:VTIMEZONE", "TZID:Europe/London",
+			"BEGIN:DAYLIGHT", "DTSTART:20240331T010000", "TZOFFSETFROM:+0000", "TZOFFSETTO:+0100", "TZNAME:BST", "END:DAYLIGHT",
+			"END:VTIMEZONE",
+		}, "\r\n"),
+		"UID:13554-2410262200--32480-1-9999-2410270900-s1@skyscanner",
+		"DTSTAMP:20241001T120000Z",
+		"DTSTART;TZID=Europe/London:20241026T220000",
+		"DTEND;TZID=Europe/Berlin:20241027T040000",
+		"SUMMARY:Flight BA902 LHR → FRA",
+		`DESCRIPTION:Flight BA902 British Airways\nFrom: London Heathrow (LHR)\nTo: Frankfurt (FRA)\nOperated by Lufthansa`,
+		"SUMMARY:Layover in Frankfurt (FRA)",
+		"DTSTART;TZID=Europe/Berlin:20241027T060000",
+		// the airport without a known time zone gets a floating time
+		"DTEND:20241027T090000",
+	} {
+		if !strings.Contains(unfolded, want) {
+			t.Errorf("calendar does not contain\n%s\n\n%s", want, unfolded)
+		}
+	}
+	if n := strings.Count(unfolded, "TRANSP:TRANSPARENT"); n != 1 {
+		t.Errorf("got %d transparent events, want 1", n)
+	}
+}
+
+func TestICSFold(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{name: "short line", line: "VERSION:2.0", want: "VERSION:2.0\r\n"},
+		{
+			name: "long line",
+			line: strings.Repeat("a", 80),
+			want: strings.Repeat("a", 75) + "\r\n " + strings.Repeat("a", 5) + "\r\n",
+		},
+		{
+			name: "multi-byte rune on the boundary",
+			line: strings.Repeat("a", 73) + "→b",
+			want: strings.Repeat("a", 73) + "\r\n →b\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := icsFold(tt.line); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestICSEscape(t *testing.T) {
+	if got, want := icsEscape("a;b,c\\d\ne"), `a\;b\,c\\d\ne`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestICSOffset(t *testing.T) {
+	tests := []struct {
+		seconds int
+		want    string
+	}{
+		{seconds: 0, want: "+0000"},
+		{seconds: 5*3600 + 30*60, want: "+0530"},
+		{seconds: -(3*3600 + 30*60), want: "-0330"},
+	}
+
+	for _, tt := range tests {
+		if got := icsOffset(tt.seconds); got != tt.want {
+			t.Errorf("got %s for %d seconds, want %s", got, tt.seconds, tt.want)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package skyscanner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	ErrorClassNetwork     = "network"
+	ErrorClassTimeout     = "timeout"
+	ErrorClassRateLimited = "rate_limited"
+	ErrorClassClient      = "client_error"
+	ErrorClassServer      = "server_error"
+	ErrorClassSearch      = "search_failed"
+	ErrorClassCircuitOpen = "circuit_open"
+	ErrorClassCanceled    = "canceled"
+
+	pollPathPrefix    = "/flights/live/search/poll/"
+	marketsPathPrefix = "/culture/markets/"
+)
+
+// Attribute is a span attribute
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Tracer starts spans. An OpenTelemetry adapter would wrap trace.Tracer and propagate spans through the context
+type Tracer interface {
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is a started span
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// RequestMetric describes a finished HTTP call to the API
+type RequestMetric struct {
+	Method string
+	// Path is the path template with session tokens and locales replaced by placeholders
+	Path         string
+	StatusCode   int
+	Duration     time.Duration
+	ResponseSize int64
+	// ErrorClass is one of the ErrorClass constants, empty for 2xx responses
+	ErrorClass string
+}
+
+// SearchMetric describes a finished Search
+type SearchMetric struct {
+	Status ResponseStatus
+	Polls  int
+	// TimeToFirstResult is zero if no itineraries were received
+	TimeToFirstResult time.Duration
+	TimeToComplete    time.Duration
+	// ErrorClass is one of the ErrorClass constants, empty for completed searches
+	ErrorClass string
+}
+
+// Metrics records client metrics. Implementations map them to latency histograms and counters
+type Metrics interface {
+	RecordRequest(ctx context.Context, m RequestMetric)
+	RecordSearch(ctx context.Context, m SearchMetric)
+}
+
+type noopTracer struct{}
+
+func (noopTracer) Start(ctx context.Context, _ string, _ ...Attribute) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(...Attribute) {}
+func (noopSpan) RecordError(error)          {}
+func (noopSpan) End()                       {}
+
+type noopMetrics struct{}
+
+func (noopMetrics) RecordRequest(context.Context, RequestMetric) {}
+func (noopMetrics) RecordSearch(context.Context, SearchMetric)   {}
+
+// instrumentedBody ends the request span and records the metric when the body is closed,
+// so the response size and the download time are included
+type instrumentedBody struct {
+	io.ReadCloser
+	ctx    context.Context
+	span   Span
+	start  time.Time
+	metric RequestMetric
+	m      Metrics
+	closed bool
+}
+
+func (b *instrumentedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.metric.ResponseSize += int64(n)
+
+	return n, err
+}
+
+func (b *instrumentedBody) Close() error {
+	err := b.ReadCloser.Close()
+	if b.closed {
+		return err
+	}
+	b.closed = true
+
+	b.metric.Duration = time.Since(b.start)
+	b.span.SetAttributes(Attribute{"http.response.body.size", b.metric.ResponseSize})
+	b.span.End()
+	b.m.RecordRequest(b.ctx, b.metric)
+
+	return err
+}
+
+// searchProgress tracks a search for SearchMetric
+type searchProgress struct {
+	start       time.Time
+	polls       int
+	firstResult time.Duration
+}
+
+func newSearchProgress() *searchProgress {
+	return &searchProgress{start: time.Now()}
+}
+
+func (p *searchProgress) received(resp *CreatePollResponse) {
+	if p.firstResult == 0 && len(responseResults(resp).Itineraries) > 0 {
+		p.firstResult = time.Since(p.start)
+	}
+}
+
+func (p *searchProgress) metric(status ResponseStatus, errorClass string) SearchMetric {
+	return SearchMetric{
+		Status:            status,
+		Polls:             p.polls,
+		TimeToFirstResult: p.firstResult,
+		TimeToComplete:    time.Since(p.start),
+		ErrorClass:        errorClass,
+	}
+}
+
+func (c client) tracer() Tracer {
+	if c.cfg.Tracer == nil {
+		return noopTracer{}
+	}
+
+	return c.cfg.Tracer
+}
+
+func (c client) metrics() Metrics {
+	if c.cfg.Metrics == nil {
+		return noopMetrics{}
+	}
+
+	return c.cfg.Metrics
+}
+
+// pathTemplate returns the URI path with the query removed and variable parts replaced by placeholders
+func pathTemplate(uri string) string {
+	if i := strings.IndexByte(uri, '?'); i >= 0 {
+		uri = uri[:i]
+	}
+
+	switch {
+	case strings.HasPrefix(uri, pollPathPrefix):
+		return pollPathPrefix + "{sessionToken}"
+	case strings.HasPrefix(uri, marketsPathPrefix):
+		return marketsPathPrefix + "{locale}"
+	}
+
+	return uri
+}
+
+// requestErrorClass returns the error class of a failed HTTP call
+func requestErrorClass(err error) string {
+	if errors.Is(err, ErrCircuitOpen) {
+		return ErrorClassCircuitOpen
+	}
+
+	var netErr net.Error
+	if errors.Is(err, ErrTimeout) || errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return ErrorClassTimeout
+	}
+	if errors.Is(err, context.Canceled) {
+		return ErrorClassCanceled
+	}
+
+	return ErrorClassNetwork
+}
+
+// statusErrorClass returns the error class of the response status, empty for 2xx statuses
+func statusErrorClass(code int) string {
+	switch {
+	case code >= 200 && code < 300:
+		return ""
+	case code == http.StatusTooManyRequests:
+		return ErrorClassRateLimited
+	case code >= 500:
+		return ErrorClassServer
+	}
+
+	return ErrorClassClient
+}
+
+// searchErrorClass returns the error class of a failed Create or Poll call of the search.
+// Only deadlines and request timeouts are timeouts, cancellation by the caller is a separate class
+func searchErrorClass(ctx context.Context, errResp *ErrorResponse) string {
+	switch {
+	case errors.Is(errResp, ErrTimeout), errors.Is(errResp, context.DeadlineExceeded):
+		return ErrorClassTimeout
+	case errors.Is(errResp, context.Canceled):
+		return ErrorClassCanceled
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return ErrorClassTimeout
+	case errors.Is(ctx.Err(), context.Canceled):
+		return ErrorClassCanceled
+	}
+
+	return statusErrorClass(errResp.Code)
+}
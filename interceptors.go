@@ -0,0 +1,110 @@
+package skyscanner
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+// Doer sends an HTTP request. *http.Client implements it
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc is an adapter to use ordinary functions as Doer
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req)
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Interceptor wraps the next Doer, e.g. to modify requests or responses, short-circuit calls or observe them
+type Interceptor func(next Doer) Doer
+
+// DefaultInterceptors returns interceptors setting the API key and the JSON content type headers.
+// They are used when Config.Interceptors is nil. The key interceptor is omitted for an empty key,
+// e.g. when Config.KeyProvider picks keys. Append to them to keep the default behavior
+func DefaultInterceptors(apiKey string) []Interceptor {
+	if apiKey == "" {
+		return []Interceptor{HeaderInterceptor("Content-Type", "application/json")}
+	}
+
+	return []Interceptor{
+		APIKeyInterceptor(AuthHeader, apiKey),
+		HeaderInterceptor("Content-Type", "application/json"),
+	}
+}
+
+// APIKeyInterceptor sets the API key to the header.
+// With Config.KeyProvider set, keys in AuthHeader are overwritten by the provider keys
+func APIKeyInterceptor(header, apiKey string) Interceptor {
+	return HeaderInterceptor(header, apiKey)
+}
+
+// HeaderInterceptor sets the header to the value on every request
+func HeaderInterceptor(name, value string) Interceptor {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set(name, value)
+			return next.Do(req)
+		})
+	}
+}
+
+// ChainInterceptors wraps the doer with the interceptors. The first interceptor is the outermost one
+func ChainInterceptors(doer Doer, interceptors ...Interceptor) Doer {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		doer = interceptors[i](doer)
+	}
+
+	return doer
+}
+
+// chain wraps the transport with the interceptors. KeyProviderInterceptor is always installed
+// right outside the transport when Config.KeyProvider is set, so it sets the key header last
+// and retries only the transport
+func (c client) chain(interceptors []Interceptor) Doer {
+	doer := c.transport()
+	if c.cfg.KeyProvider != nil {
+		doer = KeyProviderInterceptor(c.cfg.KeyProvider)(doer)
+	}
+
+	return ChainInterceptors(doer, interceptors...)
+}
+
+// transport returns the innermost doer logging and sending requests with the shared HTTP client,
+// so records include headers set by interceptors
+func (c client) transport() Doer {
+	return DoerFunc(func(req *http.Request) (*http.Response, error) {
+		start := time.Now()
+		c.logRequest(req.Context(), req, requestBody(req))
+
+		res, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		c.logResponse(req.Context(), req, res, start)
+
+		return res, nil
+	})
+}
+
+// requestBody returns a copy of the request body without consuming it
+func requestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer func() {
+		_ = body.Close()
+	}()
+
+	b, _ := io.ReadAll(body)
+
+	return b
+}
@@ -0,0 +1,178 @@
+package skyscanner
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestChainInterceptors(t *testing.T) {
+	var calls []string
+	record := func(name string) Interceptor {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" before")
+				res, err := next.Do(req)
+				calls = append(calls, name+" after")
+				return res, err
+			})
+		}
+	}
+	doer := DoerFunc(func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, "doer "+req.Header.Get("X-Test"))
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com", nil)
+	chained := ChainInterceptors(doer, record("first"), HeaderInterceptor("X-Test", "value"), record("second"))
+	if _, err := chained.Do(req); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"first before", "second before", "doer value", "second after", "first after"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("got calls %v, want %v", calls, want)
+	}
+}
+
+func TestClientInterceptors(t *testing.T) {
+	// shortCircuit answers without sending the request
+	shortCircuit := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       http.NoBody,
+				Request:    req,
+			}, nil
+		})
+	}
+
+	tests := []struct {
+		name string
+		cfg  Config
+		// interceptors are appended to the config ones
+		interceptors []Interceptor
+		wantKey      string
+		wantType     string
+		wantNoCall   bool
+	}{
+		{
+			name:     "default interceptors",
+			cfg:      Config{APIKey: "key"},
+			wantKey:  "key",
+			wantType: "application/json",
+		},
+		{
+			name: "interceptors replace the defaults",
+			cfg:  Config{APIKey: "key", Interceptors: []Interceptor{}},
+		},
+		{
+			name:     "appended to the defaults",
+			cfg:      Config{APIKey: "key", Interceptors: append(DefaultInterceptors("key"), HeaderInterceptor("Content-Type", "text/plain"))},
+			wantKey:  "key",
+			wantType: "text/plain",
+		},
+		{
+			name:     "key provider over APIKey",
+			cfg:      Config{APIKey: "ignored", KeyProvider: NewKeyPool("pool")},
+			wantKey:  "pool",
+			wantType: "application/json",
+		},
+		{
+			name:     "key provider over the key interceptor",
+			cfg:      Config{KeyProvider: NewKeyPool("pool"), Interceptors: DefaultInterceptors("stale")},
+			wantKey:  "pool",
+			wantType: "application/json",
+		},
+		{
+			name:         "short circuit",
+			cfg:          Config{APIKey: "key", Interceptors: []Interceptor{}},
+			interceptors: []Interceptor{shortCircuit},
+			wantNoCall:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+			var header http.Header
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called, header = true, r.Header.Clone()
+				_, _ = w.Write([]byte(`{"status":"RESULT_STATUS_COMPLETE"}`))
+			}))
+			defer srv.Close()
+
+			cfg := tt.cfg
+			cfg.Interceptors = append(cfg.Interceptors, tt.interceptors...)
+			c := NewClient(&cfg)
+			// the config interceptors are under test, so the server is set on the HTTP client
+			c.(*client).httpClient.Transport = serverTransport(srv.URL)
+
+			if _, errResp := c.Locales(context.Background()); errResp != nil && !tt.wantNoCall {
+				t.Fatal(errResp)
+			}
+			if called == tt.wantNoCall {
+				t.Fatalf("server called %v, want %v", called, !tt.wantNoCall)
+			}
+			if tt.wantNoCall {
+				return
+			}
+			if got := header.Get(AuthHeader); got != tt.wantKey {
+				t.Errorf("got key %q, want %q", got, tt.wantKey)
+			}
+			if got := header.Get("Content-Type"); got != tt.wantType {
+				t.Errorf("got content type %q, want %q", got, tt.wantType)
+			}
+		})
+	}
+}
+
+// serverTransport sends requests to the test server instead of the API
+func serverTransport(serverURL string) http.RoundTripper {
+	u, _ := url.Parse(serverURL)
+
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		req.URL.Scheme, req.URL.Host = u.Scheme, u.Host
+		return http.DefaultTransport.RoundTrip(req)
+	})
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestDefaultInterceptors(t *testing.T) {
+	tests := []struct {
+		apiKey  string
+		wantLen int
+	}{
+		{apiKey: "key", wantLen: 2},
+		{apiKey: "", wantLen: 1},
+	}
+
+	for _, tt := range tests {
+		var header http.Header
+		doer := DoerFunc(func(req *http.Request) (*http.Response, error) {
+			header = req.Header
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		})
+
+		interceptors := DefaultInterceptors(tt.apiKey)
+		if len(interceptors) != tt.wantLen {
+			t.Errorf("got %d interceptors for key %q, want %d", len(interceptors), tt.apiKey, tt.wantLen)
+		}
+		req := httptest.NewRequest(http.MethodPost, "https://example.com", strings.NewReader("{}"))
+		if _, err := ChainInterceptors(doer, interceptors...).Do(req); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := header[http.CanonicalHeaderKey(AuthHeader)]; ok != (tt.apiKey != "") {
+			t.Errorf("key header %q set for key %q", header.Get(AuthHeader), tt.apiKey)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package skyscanner
+
+// CheapestPricingOption returns the pricing option with the lowest price and the price itself.
+// Options without a price or with an unparsable price are skipped.
+// Returns nil if the itinerary has no priced options
+func (i ItineraryResult) CheapestPricingOption() (*PricingOption, float64) {
+	var (
+		cheapest *PricingOption
+		minPrice float64
+	)
+	for idx := range i.PricingOptions {
+		po := &i.PricingOptions[idx]
+		if po.Price.Amount == "" {
+			continue
+		}
+
+		p, err := po.Price.ToFloat()
+		if err != nil {
+			continue
+		}
+
+		if cheapest == nil || p < minPrice {
+			cheapest = po
+			minPrice = p
+		}
+	}
+
+	return cheapest, minPrice
+}
+
+// ItineraryLegs returns the legs of the itinerary in the order of flying.
+// Unknown leg IDs are skipped
+func (r *Results) ItineraryLegs(it ItineraryResult) []FlightLeg {
+	legs := make([]FlightLeg, 0, len(it.LegIds))
+	for _, id := range it.LegIds {
+		if leg, ok := r.Legs[id]; ok {
+			legs = append(legs, leg)
+		}
+	}
+
+	return legs
+}
+
+// LegSegments returns the segments of the leg in the order of flying.
+// Unknown segment IDs are skipped
+func (r *Results) LegSegments(leg FlightLeg) []Segment {
+	segments := make([]Segment, 0, len(leg.SegmentIds))
+	for _, id := range leg.SegmentIds {
+		if s, ok := r.Segments[id]; ok {
+			segments = append(segments, s)
+		}
+	}
+
+	return segments
+}
+
+// ItineraryDuration returns the sum of the itinerary legs durations in minutes
+func (r *Results) ItineraryDuration(it ItineraryResult) int32 {
+	var d int32
+	for _, leg := range r.ItineraryLegs(it) {
+		d += leg.DurationInMinutes
+	}
+
+	return d
+}
+
+// ItineraryStops returns the maximum stop count among the itinerary legs
+func (r *Results) ItineraryStops(it ItineraryResult) int32 {
+	var stops int32
+	for _, leg := range r.ItineraryLegs(it) {
+		if leg.StopCount > stops {
+			stops = leg.StopCount
+		}
+	}
+
+	return stops
+}
+
+// ItineraryCarrierIDs returns the unique marketing carrier IDs of the itinerary legs
+func (r *Results) ItineraryCarrierIDs(it ItineraryResult) []string {
+	seen := make(map[string]struct{})
+	ids := make([]string, 0)
+	for _, leg := range r.ItineraryLegs(it) {
+		for _, id := range leg.MarketingCarrierIds {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// HasAirportChange reports whether travellers have to change the airport during any connection of the leg
+func (r *Results) HasAirportChange(leg FlightLeg) bool {
+	segments := r.LegSegments(leg)
+	for i := 1; i < len(segments); i++ {
+		if segments[i-1].DestinationPlaceID != segments[i].OriginPlaceID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ItineraryHasAirportChange reports whether any leg of the itinerary has an airport change
+func (r *Results) ItineraryHasAirportChange(it ItineraryResult) bool {
+	for _, leg := range r.ItineraryLegs(it) {
+		if r.HasAirportChange(leg) {
+			return true
+		}
+	}
+
+	return false
+}
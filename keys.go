@@ -0,0 +1,217 @@
+package skyscanner
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const defaultKeyCooldown = time.Minute
+
+// ErrNoAPIKey is returned when the key provider has no usable key for the request
+var ErrNoAPIKey = errors.New("no usable api key")
+
+type tenantKey struct{}
+
+// WithTenant returns the context selecting keys of the tenant
+func WithTenant(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantID)
+}
+
+// TenantFromContext returns the tenant set by WithTenant
+func TenantFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(tenantKey{}).(string)
+	return id, ok
+}
+
+// KeyProvider picks API keys for requests
+type KeyProvider interface {
+	// Key returns a key for the request, skipping the keys which already failed for it
+	Key(ctx context.Context, exclude []string) (string, error)
+	// Report reports the response status of a request sent with the key, 0 if the request failed
+	Report(key string, status int)
+}
+
+// KeyUsage contains usage counters of a key
+type KeyUsage struct {
+	Requests int64 `json:"requests"`
+	// Exhausted counts 429 and 403 responses
+	Exhausted      int64     `json:"exhausted"`
+	Errors         int64     `json:"errors"`
+	ExhaustedUntil time.Time `json:"exhaustedUntil"`
+}
+
+// KeyPool is a KeyProvider rotating keys round-robin. Requests of a tenant set by WithTenant
+// use the tenant keys, other requests use the default keys.
+// Keys answered with 429 or 403 are skipped for the cooldown. Keys can be replaced at runtime
+type KeyPool struct {
+	// Cooldown is a time an exhausted key is skipped for. Default is 1 minute
+	Cooldown time.Duration
+
+	mu      sync.Mutex
+	keys    []string
+	tenants map[string][]string
+	next    map[string]int
+	usage   map[string]*KeyUsage
+}
+
+// NewKeyPool returns a key pool with the default keys
+func NewKeyPool(keys ...string) *KeyPool {
+	return &KeyPool{
+		Cooldown: defaultKeyCooldown,
+		keys:     keys,
+		tenants:  make(map[string][]string),
+		next:     make(map[string]int),
+		usage:    make(map[string]*KeyUsage),
+	}
+}
+
+// SetKeys replaces the default keys
+func (p *KeyPool) SetKeys(keys ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.keys = keys
+}
+
+// SetTenantKeys replaces keys of the tenant. No keys remove the tenant, so it uses the default keys
+func (p *KeyPool) SetTenantKeys(tenantID string, keys ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(keys) == 0 {
+		delete(p.tenants, tenantID)
+		return
+	}
+	p.tenants[tenantID] = keys
+}
+
+// Key implements KeyProvider
+func (p *KeyPool) Key(ctx context.Context, exclude []string) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	group, keys := "", p.keys
+	if id, ok := TenantFromContext(ctx); ok {
+		if tenantKeys, ok := p.tenants[id]; ok {
+			group, keys = "tenant:"+id, tenantKeys
+		}
+	}
+
+	now := time.Now()
+	for i := 0; i < len(keys); i++ {
+		idx := (p.next[group] + i) % len(keys)
+		key := keys[idx]
+		if containsString(exclude, key) {
+			continue
+		}
+		if u, ok := p.usage[key]; ok && now.Before(u.ExhaustedUntil) {
+			continue
+		}
+
+		p.next[group] = idx + 1
+		return key, nil
+	}
+
+	return "", ErrNoAPIKey
+}
+
+// Report implements KeyProvider
+func (p *KeyPool) Report(key string, status int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	u, ok := p.usage[key]
+	if !ok {
+		u = &KeyUsage{}
+		p.usage[key] = u
+	}
+
+	u.Requests++
+	switch {
+	case keyExhausted(status):
+		u.Exhausted++
+		cooldown := p.Cooldown
+		if cooldown <= 0 {
+			cooldown = defaultKeyCooldown
+		}
+		u.ExhaustedUntil = time.Now().Add(cooldown)
+	case status == 0 || status >= 500:
+		u.Errors++
+	}
+}
+
+// Usage returns usage counters per key
+func (p *KeyPool) Usage() map[string]KeyUsage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	usage := make(map[string]KeyUsage, len(p.usage))
+	for k, u := range p.usage {
+		usage[k] = *u
+	}
+
+	return usage
+}
+
+// KeyProviderInterceptor sets keys picked by the provider to the API key header.
+// When a key is answered with 429 or 403, the request is retried with the next key
+func KeyProviderInterceptor(p KeyProvider) Interceptor {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			var tried []string
+			var exhausted *http.Response
+			for {
+				key, err := p.Key(req.Context(), tried)
+				if err != nil {
+					if exhausted != nil {
+						return exhausted, nil
+					}
+					return nil, err
+				}
+
+				if exhausted != nil {
+					if req.GetBody == nil {
+						return exhausted, nil
+					}
+					body, err := req.GetBody()
+					if err != nil {
+						return exhausted, nil
+					}
+					_ = exhausted.Body.Close()
+					req.Body = body
+				}
+				req.Header.Set(AuthHeader, key)
+				tried = append(tried, key)
+
+				res, err := next.Do(req)
+				if err != nil {
+					p.Report(key, 0)
+					return nil, err
+				}
+
+				p.Report(key, res.StatusCode)
+				if !keyExhausted(res.StatusCode) {
+					return res, nil
+				}
+				exhausted = res
+			}
+		})
+	}
+}
+
+func keyExhausted(status int) bool {
+	return status == http.StatusTooManyRequests || status == http.StatusForbidden
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
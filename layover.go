@@ -0,0 +1,172 @@
+package skyscanner
+
+import "time"
+
+// MCTTable contains minimum connection times
+type MCTTable struct {
+	// Default is used for airports missing in the Airports table
+	Default time.Duration
+	// Airports contains connection times by airport IATA code
+	Airports map[string]time.Duration
+	// AirportChange is used when travellers have to change the airport
+	AirportChange time.Duration
+	// SelfTransfer is the minimal layover for non-protected self transfers,
+	// which need time to collect and recheck baggage
+	SelfTransfer time.Duration
+}
+
+// DefaultMCTTable contains commonly used connection times
+var DefaultMCTTable = MCTTable{
+	Default:       45 * time.Minute,
+	AirportChange: 3 * time.Hour,
+	SelfTransfer:  3 * time.Hour,
+}
+
+// Connection describes a connection between two segments of a leg
+type Connection struct {
+	ArrivalSegmentID   string `json:"arrivalSegmentId"`
+	DepartureSegmentID string `json:"departureSegmentId"`
+	// ArrivalPlaceID is where the arrival segment lands
+	ArrivalPlaceID string `json:"arrivalPlaceId"`
+	// DeparturePlaceID is where the departure segment takes off. It differs from ArrivalPlaceID on airport changes
+	DeparturePlaceID  string        `json:"departurePlaceId"`
+	Layover           time.Duration `json:"-"`
+	AirportChange     bool          `json:"airportChange"`
+	Overnight         bool          `json:"overnight"` // the departure is on a later day than the arrival
+	MinConnectionTime time.Duration `json:"-"`
+	BelowMCT          bool          `json:"belowMct"`
+	// LayoverInMinutes and MinConnectionTimeInMinutes are Layover and MinConnectionTime in whole minutes
+	LayoverInMinutes           int32 `json:"layoverInMinutes"`
+	MinConnectionTimeInMinutes int32 `json:"minConnectionTimeInMinutes"`
+}
+
+// LegConnections contains connections of a leg
+type LegConnections struct {
+	LegID        string        `json:"legId"`
+	Connections  []Connection  `json:"connections"`
+	TotalLayover time.Duration `json:"-"`
+	// TotalLayoverInMinutes is TotalLayover in whole minutes
+	TotalLayoverInMinutes int32 `json:"totalLayoverInMinutes"`
+}
+
+// ItineraryConnections contains connections of the itinerary legs
+type ItineraryConnections struct {
+	ItineraryID string           `json:"itineraryId"`
+	Legs        []LegConnections `json:"legs"`
+	// Risky is set if any connection is below MCT or has an airport change
+	Risky bool `json:"risky"`
+	// RiskySelfTransfer is set if the itinerary is sold as a non-protected self transfer
+	// and any connection is risky or shorter than the self transfer MCT
+	RiskySelfTransfer bool `json:"riskySelfTransfer"`
+}
+
+// HasRisk reports whether any connection is below MCT or has an airport change
+func (l *LegConnections) HasRisk() bool {
+	for _, c := range l.Connections {
+		if c.BelowMCT || c.AirportChange {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AnalyzeLeg walks the leg segments and describes every connection between them.
+// Returns nil if the leg is unknown
+func (r *Results) AnalyzeLeg(legID string, mct MCTTable) *LegConnections {
+	leg, ok := r.Legs[legID]
+	if !ok {
+		return nil
+	}
+
+	lc := &LegConnections{LegID: legID, Connections: make([]Connection, 0)}
+	for i := 1; i < len(leg.SegmentIds); i++ {
+		arr, ok := r.Segments[leg.SegmentIds[i-1]]
+		if !ok {
+			continue
+		}
+		dep, ok := r.Segments[leg.SegmentIds[i]]
+		if !ok {
+			continue
+		}
+
+		c := Connection{
+			ArrivalSegmentID:   leg.SegmentIds[i-1],
+			DepartureSegmentID: leg.SegmentIds[i],
+			ArrivalPlaceID:     arr.DestinationPlaceID,
+			DeparturePlaceID:   dep.OriginPlaceID,
+			Layover:            dep.DepartureDateTime.Time().Sub(arr.ArrivalDateTime.Time()),
+			AirportChange:      arr.DestinationPlaceID != dep.OriginPlaceID,
+			Overnight:          dateOnly(dep.DepartureDateTime.Time()).After(dateOnly(arr.ArrivalDateTime.Time())),
+		}
+		c.MinConnectionTime = mct.minConnectionTime(r.placeCode(c.ArrivalPlaceID), c.AirportChange)
+		c.BelowMCT = c.Layover < c.MinConnectionTime
+		c.LayoverInMinutes = minutes(c.Layover)
+		c.MinConnectionTimeInMinutes = minutes(c.MinConnectionTime)
+
+		lc.Connections = append(lc.Connections, c)
+		lc.TotalLayover += c.Layover
+	}
+	lc.TotalLayoverInMinutes = minutes(lc.TotalLayover)
+
+	return lc
+}
+
+// AnalyzeItinerary describes connections of every itinerary leg.
+// Returns nil if the itinerary is unknown
+func (r *Results) AnalyzeItinerary(itineraryID string, mct MCTTable) *ItineraryConnections {
+	it, ok := r.Itineraries[itineraryID]
+	if !ok {
+		return nil
+	}
+
+	ic := &ItineraryConnections{ItineraryID: itineraryID, Legs: make([]LegConnections, 0, len(it.LegIds))}
+	shortForSelfTransfer := false
+	for _, id := range it.LegIds {
+		lc := r.AnalyzeLeg(id, mct)
+		if lc == nil {
+			continue
+		}
+
+		if lc.HasRisk() {
+			ic.Risky = true
+		}
+		for _, c := range lc.Connections {
+			if c.Layover < mct.SelfTransfer {
+				shortForSelfTransfer = true
+			}
+		}
+		ic.Legs = append(ic.Legs, *lc)
+	}
+
+	if ic.Risky || shortForSelfTransfer {
+		for _, po := range it.PricingOptions {
+			if po.TransferType == TransferTypeSelfTransfer {
+				ic.RiskySelfTransfer = true
+				break
+			}
+		}
+	}
+
+	return ic
+}
+
+func (m MCTTable) minConnectionTime(airport string, airportChange bool) time.Duration {
+	if airportChange && m.AirportChange > 0 {
+		return m.AirportChange
+	}
+	if d, ok := m.Airports[airport]; ok {
+		return d
+	}
+
+	return m.Default
+}
+
+// placeCode returns the IATA code of the place or its ID if the code is unknown
+func (r *Results) placeCode(placeID string) string {
+	if p, ok := r.Places[placeID]; ok && p.IATA != "" {
+		return p.IATA
+	}
+
+	return placeID
+}
@@ -0,0 +1,127 @@
+package skyscanner
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAnalyzeItinerary(t *testing.T) {
+	// wantConnection is the connection of the only itinerary leg
+	type wantConnection struct {
+		layover       int32
+		mct           int32
+		belowMCT      bool
+		airportChange bool
+		overnight     bool
+	}
+
+	tests := []struct {
+		name          string
+		id            string
+		mct           MCTTable
+		want          *wantConnection
+		wantRisky     bool
+		wantRiskySelf bool
+	}{
+		{
+			name:          "self transfer shorter than the self transfer MCT",
+			id:            "onestop",
+			mct:           DefaultMCTTable,
+			want:          &wantConnection{layover: 90, mct: 45},
+			wantRiskySelf: true,
+		},
+		{
+			name:          "airport MCT",
+			id:            "onestop",
+			mct:           MCTTable{Default: 45 * time.Minute, Airports: map[string]time.Duration{"FRA": 2 * time.Hour}},
+			want:          &wantConnection{layover: 90, mct: 120, belowMCT: true},
+			wantRisky:     true,
+			wantRiskySelf: true,
+		},
+		{
+			name:      "airport change MCT",
+			id:        "change",
+			mct:       DefaultMCTTable,
+			want:      &wantConnection{layover: 150, mct: 180, belowMCT: true, airportChange: true},
+			wantRisky: true,
+		},
+		{
+			name:      "airport change without its MCT",
+			id:        "change",
+			mct:       MCTTable{Default: 45 * time.Minute},
+			want:      &wantConnection{layover: 150, mct: 45, airportChange: true},
+			wantRisky: true,
+		},
+		{
+			name: "overnight connection",
+			id:   "overnight",
+			mct:  DefaultMCTTable,
+			want: &wantConnection{layover: 120, mct: 45, overnight: true},
+		},
+		{
+			name: "direct flight",
+			id:   "direct",
+			mct:  DefaultMCTTable,
+		},
+	}
+
+	r := testResults()
+	r.Segments["jfk-lhr"] = Segment{
+		OriginPlaceID: "jfk", DestinationPlaceID: "lhr",
+		ArrivalDateTime: LocalDatetime{Year: 2024, Month: 10, Day: 10, Hour: 23},
+	}
+	r.Segments["lhr-fra-next-day"] = Segment{
+		OriginPlaceID: "lhr", DestinationPlaceID: "fra",
+		DepartureDateTime: LocalDatetime{Year: 2024, Month: 10, Day: 11, Hour: 1},
+	}
+	r.Legs["leg-overnight"] = FlightLeg{SegmentIds: []string{"jfk-lhr", "lhr-fra-next-day"}}
+	r.Itineraries["overnight"] = ItineraryResult{LegIds: []string{"leg-overnight"}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := r.AnalyzeItinerary(tt.id, tt.mct)
+			if ic == nil || len(ic.Legs) != 1 {
+				t.Fatalf("got %+v, want one leg", ic)
+			}
+			if ic.Risky != tt.wantRisky || ic.RiskySelfTransfer != tt.wantRiskySelf {
+				t.Errorf("got risky %v and risky self transfer %v, want %v and %v", ic.Risky, ic.RiskySelfTransfer, tt.wantRisky, tt.wantRiskySelf)
+			}
+
+			connections := ic.Legs[0].Connections
+			if tt.want == nil {
+				if len(connections) != 0 {
+					t.Errorf("got connections %+v, want none", connections)
+				}
+				return
+			}
+			if len(connections) != 1 {
+				t.Fatalf("got %d connections, want 1", len(connections))
+			}
+			c := connections[0]
+			got := wantConnection{
+				layover:       c.LayoverInMinutes,
+				mct:           c.MinConnectionTimeInMinutes,
+				belowMCT:      c.BelowMCT,
+				airportChange: c.AirportChange,
+				overnight:     c.Overnight,
+			}
+			if got != *tt.want {
+				t.Errorf("got %+v, want %+v", got, *tt.want)
+			}
+			if ic.Legs[0].TotalLayoverInMinutes != tt.want.layover {
+				t.Errorf("total layover is %d minutes, want %d", ic.Legs[0].TotalLayoverInMinutes, tt.want.layover)
+			}
+		})
+	}
+}
+
+func TestAnalyzeUnknown(t *testing.T) {
+	r := testResults()
+
+	if ic := r.AnalyzeItinerary("unknown", DefaultMCTTable); ic != nil {
+		t.Errorf("got %+v for an unknown itinerary", ic)
+	}
+	if lc := r.AnalyzeLeg("unknown", DefaultMCTTable); lc != nil {
+		t.Errorf("got %+v for an unknown leg", lc)
+	}
+}
@@ -0,0 +1,323 @@
+package skyscanner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// Logger logs client requests and responses. *slog.Logger implements it
+type Logger interface {
+	Enabled(ctx context.Context, level slog.Level) bool
+	LogAttrs(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr)
+}
+
+// LogOptions configures request and response logging
+type LogOptions struct {
+	// RequestLevel is a level of request records. Default is slog.LevelDebug
+	RequestLevel slog.Leveler
+	// ResponseLevel is a level of 2xx response records. Default is slog.LevelDebug
+	ResponseLevel slog.Leveler
+	// ErrorLevel is a level of failed calls and non-2xx response records. Default is slog.LevelWarn
+	ErrorLevel slog.Leveler
+	// LogBodies includes request and response bodies
+	LogBodies bool
+	// BodyLimit truncates logged bodies to the number of bytes. Default is 4096
+	BodyLimit int
+	// RedactFields are names of JSON body fields and query parameters whose values are replaced with "[REDACTED]".
+	// Names are matched case-insensitively at any depth. The API key header is always redacted
+	RedactFields []string
+}
+
+const defaultLogBodyLimit = 4096
+
+// withLogDefaults returns the options with unset levels and limits set to defaults
+func (o LogOptions) withLogDefaults() LogOptions {
+	if o.RequestLevel == nil {
+		o.RequestLevel = slog.LevelDebug
+	}
+	if o.ResponseLevel == nil {
+		o.ResponseLevel = slog.LevelDebug
+	}
+	if o.ErrorLevel == nil {
+		o.ErrorLevel = slog.LevelWarn
+	}
+	if o.BodyLimit <= 0 {
+		o.BodyLimit = defaultLogBodyLimit
+	}
+
+	return o
+}
+
+func (c client) logRequest(ctx context.Context, req *http.Request, body []byte) {
+	if c.cfg.Logger == nil || !c.cfg.Logger.Enabled(ctx, c.logOptions.RequestLevel.Level()) {
+		return
+	}
+
+	o := c.logOptions
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", redactURL(req.URL, o.RedactFields)),
+		slog.Any("headers", redactHeaders(req.Header)),
+	}
+	if o.LogBodies {
+		attrs = append(attrs, slog.String("body", redactBody(body, o.RedactFields, o.BodyLimit, false)))
+	}
+
+	c.cfg.Logger.LogAttrs(ctx, o.RequestLevel.Level(), "skyscanner request", attrs...)
+}
+
+// logResponse logs the response. If bodies are logged, the record is written once the body is read
+// to the end, fails or is closed, so reads stay subject to the body read timeout and keep their errors
+func (c client) logResponse(ctx context.Context, req *http.Request, res *http.Response, start time.Time) {
+	if c.cfg.Logger == nil {
+		return
+	}
+
+	o := c.logOptions
+	level := o.ResponseLevel.Level()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		level = o.ErrorLevel.Level()
+	}
+	if !c.cfg.Logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", redactURL(req.URL, o.RedactFields)),
+		slog.Int("status", res.StatusCode),
+		slog.Duration("duration", time.Since(start)),
+	}
+	if !o.LogBodies {
+		c.cfg.Logger.LogAttrs(ctx, level, "skyscanner response", attrs...)
+		return
+	}
+
+	res.Body = &loggedBody{ReadCloser: res.Body, limit: o.BodyLimit, log: func(body []byte, truncated bool, err error) {
+		if err != nil {
+			attrs = append(attrs, slog.String("bodyError", err.Error()))
+		}
+		attrs = append(attrs, slog.String("body", redactBody(body, o.RedactFields, o.BodyLimit, truncated)))
+		c.cfg.Logger.LogAttrs(ctx, level, "skyscanner response", attrs...)
+	}}
+}
+
+// loggedBody copies up to limit read bytes and calls log once the body is read to the end, fails or is closed.
+// Only the logged prefix is kept, the rest of the body is passed through
+type loggedBody struct {
+	io.ReadCloser
+	limit int
+	log   func(body []byte, truncated bool, err error)
+
+	buf       bytes.Buffer
+	truncated bool
+	once      sync.Once
+}
+
+func (b *loggedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.keep(p[:n])
+	switch {
+	case err == io.EOF:
+		b.done(nil)
+	case err != nil:
+		b.done(err)
+	}
+
+	return n, err
+}
+
+func (b *loggedBody) keep(p []byte) {
+	if free := b.limit - b.buf.Len(); len(p) > free {
+		p = p[:free]
+		b.truncated = true
+	}
+	b.buf.Write(p)
+}
+
+func (b *loggedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.done(nil)
+
+	return err
+}
+
+func (b *loggedBody) done(err error) {
+	b.once.Do(func() {
+		b.log(b.buf.Bytes(), b.truncated, err)
+	})
+}
+
+func (c client) logError(ctx context.Context, method, uri string, err error, start time.Time) {
+	if c.cfg.Logger == nil || !c.cfg.Logger.Enabled(ctx, c.logOptions.ErrorLevel.Level()) {
+		return
+	}
+
+	c.cfg.Logger.LogAttrs(ctx, c.logOptions.ErrorLevel.Level(), "skyscanner request failed",
+		slog.String("method", method),
+		slog.String("path", pathTemplate(uri)),
+		slog.Duration("duration", time.Since(start)),
+		slog.String("error", err.Error()),
+	)
+}
+
+func redactHeaders(h http.Header) map[string]string {
+	headers := make(map[string]string, len(h))
+	for k := range h {
+		v := h.Get(k)
+		if strings.EqualFold(k, AuthHeader) {
+			v = redacted
+		}
+		headers[k] = v
+	}
+
+	return headers
+}
+
+func redactURL(u *url.URL, fields []string) string {
+	if u.RawQuery == "" || len(fields) == 0 {
+		return u.String()
+	}
+
+	redactedURL := *u
+	q := u.Query()
+	for k := range q {
+		if fieldRedacted(k, fields) {
+			q.Set(k, redacted)
+		}
+	}
+	redactedURL.RawQuery = q.Encode()
+
+	return redactedURL.String()
+}
+
+// redactBody returns the body with the fields redacted, truncated to the limit.
+// The body can be a truncated prefix of a JSON document. Bodies which are not JSON are only truncated
+func redactBody(body []byte, fields []string, limit int, truncated bool) string {
+	if len(fields) > 0 && len(body) > 0 {
+		if b, ok := redactJSON(body, fields); ok {
+			body = b
+		}
+	}
+
+	if len(body) > limit {
+		body = body[:limit]
+		truncated = true
+	}
+	if truncated {
+		return string(body) + "...(truncated)"
+	}
+
+	return string(body)
+}
+
+// jsonContainer is an open object or array of the JSON document being redacted
+type jsonContainer struct {
+	object bool
+	// tokens is a number of keys and values written to the container
+	tokens int
+}
+
+// redactJSON re-encodes the JSON value with the fields redacted. A truncated value is re-encoded
+// up to its last complete token, so partly read values of redacted fields are dropped.
+// It reports false if the body does not start with a JSON value
+func redactJSON(body []byte, fields []string) ([]byte, bool) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var out bytes.Buffer
+	var stack []jsonContainer
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return out.Bytes(), out.Len() > 0
+		}
+
+		if delim, ok := tok.(json.Delim); ok && (delim == '}' || delim == ']') {
+			stack = stack[:len(stack)-1]
+			out.WriteByte(byte(delim))
+			if len(stack) == 0 {
+				return out.Bytes(), true
+			}
+			continue
+		}
+
+		isKey := false
+		if len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			isKey = top.object && top.tokens%2 == 0
+			switch {
+			case isKey && top.tokens > 0, !top.object && top.tokens > 0:
+				out.WriteByte(',')
+			case top.object && !isKey:
+				out.WriteByte(':')
+			}
+			top.tokens++
+		}
+
+		switch v := tok.(type) {
+		case json.Delim:
+			out.WriteByte(byte(v))
+			stack = append(stack, jsonContainer{object: v == '{'})
+			continue
+		case json.Number:
+			out.WriteString(v.String())
+		default:
+			b, _ := json.Marshal(v)
+			out.Write(b)
+		}
+
+		if key, ok := tok.(string); ok && isKey && fieldRedacted(key, fields) {
+			out.WriteString(`:"` + redacted + `"`)
+			stack[len(stack)-1].tokens++
+			if err := skipJSONValue(dec); err != nil {
+				return out.Bytes(), true
+			}
+		}
+
+		if len(stack) == 0 {
+			return out.Bytes(), true
+		}
+	}
+}
+
+// skipJSONValue reads the next value of the decoder
+func skipJSONValue(dec *json.Decoder) error {
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if delim, ok := tok.(json.Delim); ok {
+			if delim == '{' || delim == '[' {
+				depth++
+			} else {
+				depth--
+			}
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+func fieldRedacted(name string, fields []string) bool {
+	for _, f := range fields {
+		if strings.EqualFold(name, f) {
+			return true
+		}
+	}
+
+	return false
+}
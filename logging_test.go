@@ -0,0 +1,182 @@
+package skyscanner
+
+import (
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	fields := []string{"deepLink", "token"}
+
+	tests := []struct {
+		name      string
+		body      string
+		fields    []string
+		limit     int
+		truncated bool
+		want      string
+	}{
+		{
+			name:   "nested fields",
+			body:   `{"token":"abc","items":[{"deepLink":{"url":"x"},"price":1.5}],"n":null}`,
+			fields: fields,
+			limit:  1000,
+			want:   `{"token":"[REDACTED]","items":[{"deepLink":"[REDACTED]","price":1.5}],"n":null}`,
+		},
+		{
+			name:   "case-insensitive names",
+			body:   `{"TOKEN":"abc","ok":true}`,
+			fields: fields,
+			limit:  1000,
+			want:   `{"TOKEN":"[REDACTED]","ok":true}`,
+		},
+		{
+			name:   "array at the top level",
+			body:   `[{"token":"a"},{"token":"b"}]`,
+			fields: fields,
+			limit:  1000,
+			want:   `[{"token":"[REDACTED]"},{"token":"[REDACTED]"}]`,
+		},
+		{
+			name:  "no fields",
+			body:  `{"token": "abc"}`,
+			limit: 1000,
+			want:  `{"token": "abc"}`,
+		},
+		{
+			name:   "truncated output",
+			body:   `{"token":"abc","name":"London Heathrow"}`,
+			fields: fields,
+			limit:  30,
+			want:   `{"token":"[REDACTED]","name":"...(truncated)`,
+		},
+		{
+			name:      "truncated prefix inside a redacted value",
+			body:      `{"name":"LHR","deepLink":"https://example.com/book?sess`,
+			fields:    fields,
+			limit:     1000,
+			truncated: true,
+			want:      `{"name":"LHR","deepLink":"[REDACTED]"...(truncated)`,
+		},
+		{
+			name:      "truncated prefix inside another value",
+			body:      `{"token":"abc","name":"London Hea`,
+			fields:    fields,
+			limit:     1000,
+			truncated: true,
+			want:      `{"token":"[REDACTED]","name"...(truncated)`,
+		},
+		{
+			name:   "not JSON",
+			body:   `<html>token=abc</html>`,
+			fields: fields,
+			limit:  6,
+			want:   `<html>...(truncated)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactBody([]byte(tt.body), tt.fields, tt.limit, tt.truncated); got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	u, _ := url.Parse("https://example.com/culture/nearestculture?ipAddress=1.2.3.4&locale=en-GB")
+
+	if got := redactURL(u, []string{"IPADDRESS"}); got != "https://example.com/culture/nearestculture?ipAddress=%5BREDACTED%5D&locale=en-GB" {
+		t.Errorf("unexpected url %s", got)
+	}
+	if got := redactURL(u, nil); got != u.String() {
+		t.Errorf("unexpected url %s", got)
+	}
+}
+
+// errorReader returns the data and then the error
+type errorReader struct {
+	data string
+	err  error
+}
+
+func (r *errorReader) Read(p []byte) (int, error) {
+	if r.data == "" {
+		return 0, r.err
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+
+	return n, nil
+}
+
+func TestLoggedBody(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		err           error
+		limit         int
+		wantLogged    string
+		wantTruncated bool
+	}{
+		{name: "short body", body: "abc", err: io.EOF, limit: 10, wantLogged: "abc"},
+		{name: "body over the limit", body: strings.Repeat("x", 100), err: io.EOF, limit: 10, wantLogged: strings.Repeat("x", 10), wantTruncated: true},
+		{name: "read error", body: "abc", err: errors.New("reset"), limit: 10, wantLogged: "abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			var logged string
+			var truncated bool
+			var logErr error
+			b := &loggedBody{
+				ReadCloser: io.NopCloser(&errorReader{data: tt.body, err: tt.err}),
+				limit:      tt.limit,
+				log: func(body []byte, tr bool, err error) {
+					calls++
+					logged, truncated, logErr = string(body), tr, err
+				},
+			}
+
+			wantErr := tt.err
+			if wantErr == io.EOF {
+				wantErr = nil
+			}
+			read, err := readSmall(b)
+			_ = b.Close()
+			if read != tt.body || err != wantErr {
+				t.Errorf("read %d bytes with %v, want %d bytes with %v", len(read), err, len(tt.body), wantErr)
+			}
+			if calls != 1 || logged != tt.wantLogged || truncated != tt.wantTruncated {
+				t.Errorf("logged %d times %q truncated %v", calls, logged, truncated)
+			}
+			if b.buf.Cap() > 64+tt.limit {
+				t.Errorf("buffer grew to %d bytes", b.buf.Cap())
+			}
+			if logErr != wantErr {
+				t.Errorf("logged error %v, want %v", logErr, wantErr)
+			}
+		})
+	}
+}
+
+// readSmall reads the reader in small chunks like a streaming decoder does
+func readSmall(r io.Reader) (string, error) {
+	var sb strings.Builder
+	p := make([]byte, 7)
+	for {
+		n, err := r.Read(p)
+		sb.Write(p[:n])
+		if err == io.EOF {
+			return sb.String(), nil
+		}
+		if err != nil {
+			return sb.String(), err
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package skyscanner
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// MergedResults contains results of several searches merged together
+type MergedResults struct {
+	Results        *Results        `json:"results"`
+	Stats          *Stats          `json:"stats"`
+	SortingOptions *SortingOptions `json:"sortingOptions"`
+	// Sources maps merged itinerary IDs to the searches they came from
+	Sources map[string]ItinerarySource `json:"sources"`
+}
+
+// ItinerarySource references an itinerary in the search it came from
+type ItinerarySource struct {
+	// SessionIndex is an index of the search response passed to MergeResults
+	SessionIndex int    `json:"sessionIndex"`
+	SessionToken string `json:"sessionToken"`
+	// ItineraryID is the itinerary ID in the search
+	ItineraryID string `json:"itineraryId"`
+}
+
+// MergeResults merges results of the search responses and recomputes stats and sorting options for them.
+// Places, carriers, agents and alliances are shared by ID, the first met value wins.
+// Itineraries, legs and segments with the same ID and the same content are shared as well.
+// If their content differs, the later one gets the "#<response index>" ID suffix
+// and references to it are rewritten. Responses without results are skipped
+func MergeResults(responses ...*CreatePollResponse) *MergedResults {
+	merged := &Results{
+		Itineraries: make(map[string]ItineraryResult),
+		Legs:        make(map[string]FlightLeg),
+		Segments:    make(map[string]Segment),
+		Places:      make(map[string]Place),
+		Carriers:    make(map[string]Carrier),
+		Agents:      make(map[string]Agent),
+		Alliances:   make(map[string]Alliance),
+	}
+	sources := make(map[string]ItinerarySource)
+
+	for i, resp := range responses {
+		if resp == nil || resp.Content == nil || resp.Content.Results == nil {
+			continue
+		}
+		r := resp.Content.Results
+
+		mergeShared(merged.Places, r.Places)
+		mergeShared(merged.Carriers, r.Carriers)
+		mergeShared(merged.Agents, r.Agents)
+		mergeShared(merged.Alliances, r.Alliances)
+
+		segmentIDs := make(map[string]string, len(r.Segments))
+		for id, s := range r.Segments {
+			segmentIDs[id] = mergeEntity(merged.Segments, id, s, i)
+		}
+
+		legIDs := make(map[string]string, len(r.Legs))
+		for id, leg := range r.Legs {
+			leg.SegmentIds = remapIDs(leg.SegmentIds, segmentIDs)
+			legIDs[id] = mergeEntity(merged.Legs, id, leg, i)
+		}
+
+		for id, it := range r.Itineraries {
+			it.LegIds = remapIDs(it.LegIds, legIDs)
+			it.PricingOptions = remapFareSegments(it.PricingOptions, segmentIDs)
+			newID := mergeEntity(merged.Itineraries, id, it, i)
+			if _, ok := sources[newID]; !ok {
+				sources[newID] = ItinerarySource{
+					SessionIndex: i,
+					SessionToken: resp.SessionToken,
+					ItineraryID:  id,
+				}
+			}
+		}
+	}
+
+	return &MergedResults{
+		Results:        merged,
+		Stats:          merged.ComputeStats(),
+		SortingOptions: merged.ComputeSortingOptions(),
+		Sources:        sources,
+	}
+}
+
+func mergeShared[T any](dst, src map[string]T) {
+	for id, v := range src {
+		if _, ok := dst[id]; !ok {
+			dst[id] = v
+		}
+	}
+}
+
+// mergeEntity puts the value to dst and returns its ID in dst
+func mergeEntity[T any](dst map[string]T, id string, v T, responseIndex int) string {
+	existing, ok := dst[id]
+	if !ok {
+		dst[id] = v
+		return id
+	}
+	if reflect.DeepEqual(existing, v) {
+		return id
+	}
+
+	newID := id + "#" + strconv.Itoa(responseIndex)
+	dst[newID] = v
+
+	return newID
+}
+
+func remapIDs(ids []string, mapping map[string]string) []string {
+	remapped := make([]string, len(ids))
+	for i, id := range ids {
+		if newID, ok := mapping[id]; ok {
+			remapped[i] = newID
+			continue
+		}
+		remapped[i] = id
+	}
+
+	return remapped
+}
+
+// remapFareSegments returns a copy of the pricing options with fare segment IDs remapped
+func remapFareSegments(options []PricingOption, segmentIDs map[string]string) []PricingOption {
+	if options == nil {
+		return nil
+	}
+
+	remapped := make([]PricingOption, len(options))
+	for i, o := range options {
+		if o.Items != nil {
+			items := make([]LivePricingOptionItem, len(o.Items))
+			for j, item := range o.Items {
+				item.Fares = remapFares(item.Fares, segmentIDs)
+				items[j] = item
+			}
+			o.Items = items
+		}
+		remapped[i] = o
+	}
+
+	return remapped
+}
+
+func remapFares(fares []LivePricingOptionItemFares, segmentIDs map[string]string) []LivePricingOptionItemFares {
+	if fares == nil {
+		return nil
+	}
+
+	remapped := make([]LivePricingOptionItemFares, len(fares))
+	for i, f := range fares {
+		if newID, ok := segmentIDs[f.SegmentID]; ok {
+			f.SegmentID = newID
+		}
+		remapped[i] = f
+	}
+
+	return remapped
+}
@@ -0,0 +1,116 @@
+package skyscanner
+
+import (
+	"reflect"
+	"testing"
+)
+
+func mergeTestResponse(token, flightNumber string) *CreatePollResponse {
+	return &CreatePollResponse{
+		SessionToken: token,
+		Content: &Content{Results: &Results{
+			Itineraries: map[string]ItineraryResult{"it1": {
+				LegIds: []string{"leg1"},
+				PricingOptions: []PricingOption{{
+					Price: Price{Amount: "100000", Unit: PriceUnitMilli},
+					Items: []LivePricingOptionItem{{
+						AgentID: "ba",
+						Fares:   []LivePricingOptionItemFares{{SegmentID: "seg1", BookingCode: "Y"}},
+					}},
+				}},
+			}},
+			Legs: map[string]FlightLeg{"leg1": {
+				OriginPlaceID:      "lhr",
+				DestinationPlaceID: "jfk",
+				DurationInMinutes:  480,
+				SegmentIds:         []string{"seg1"},
+			}},
+			Segments: map[string]Segment{"seg1": {
+				OriginPlaceID:         "lhr",
+				DestinationPlaceID:    "jfk",
+				DurationInMinutes:     480,
+				MarketingFlightNumber: flightNumber,
+			}},
+			Places: map[string]Place{"lhr": {Name: "London Heathrow"}},
+		}},
+	}
+}
+
+func TestMergeResultsIDs(t *testing.T) {
+	tests := []struct {
+		name           string
+		secondFlight   string
+		wantItinerary  string
+		wantLeg        string
+		wantSegment    string
+		wantItineraryN int
+	}{
+		{
+			name:           "same content is shared",
+			secondFlight:   "117",
+			wantItinerary:  "it1",
+			wantLeg:        "leg1",
+			wantSegment:    "seg1",
+			wantItineraryN: 1,
+		},
+		{
+			name:           "different content is suffixed with the response index",
+			secondFlight:   "175",
+			wantItinerary:  "it1#2",
+			wantLeg:        "leg1#2",
+			wantSegment:    "seg1#2",
+			wantItineraryN: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, second := mergeTestResponse("a", "117"), mergeTestResponse("b", tt.secondFlight)
+			original := mergeTestResponse("b", tt.secondFlight)
+
+			merged := MergeResults(first, nil, second)
+			r := merged.Results
+
+			if len(r.Itineraries) != tt.wantItineraryN {
+				t.Fatalf("got %d itineraries, want %d", len(r.Itineraries), tt.wantItineraryN)
+			}
+			it, ok := r.Itineraries[tt.wantItinerary]
+			if !ok {
+				t.Fatalf("itinerary %s is missing in %v", tt.wantItinerary, r.Itineraries)
+			}
+			if got := it.LegIds; !reflect.DeepEqual(got, []string{tt.wantLeg}) {
+				t.Errorf("itinerary legs are %v, want %s", got, tt.wantLeg)
+			}
+			if got := r.Legs[tt.wantLeg].SegmentIds; !reflect.DeepEqual(got, []string{tt.wantSegment}) {
+				t.Errorf("leg segments are %v, want %s", got, tt.wantSegment)
+			}
+			if got := it.PricingOptions[0].Items[0].Fares[0].SegmentID; got != tt.wantSegment {
+				t.Errorf("fare segment is %s, want %s", got, tt.wantSegment)
+			}
+			if got := r.Segments[tt.wantSegment].MarketingFlightNumber; got != tt.secondFlight {
+				t.Errorf("segment %s has flight %s, want %s", tt.wantSegment, got, tt.secondFlight)
+			}
+			if got := r.Segments["seg1"].MarketingFlightNumber; got != "117" {
+				t.Errorf("first segment has flight %s, want 117", got)
+			}
+
+			if tt.wantItineraryN == 2 {
+				want := ItinerarySource{SessionIndex: 2, SessionToken: "b", ItineraryID: "it1"}
+				if got := merged.Sources[tt.wantItinerary]; got != want {
+					t.Errorf("source is %+v, want %+v", got, want)
+				}
+			}
+			if !reflect.DeepEqual(second, original) {
+				t.Error("merging modified the response")
+			}
+		})
+	}
+}
+
+func TestMergeResultsSkipsEmptyResponses(t *testing.T) {
+	merged := MergeResults(nil, &CreatePollResponse{}, &CreatePollResponse{Content: &Content{}})
+
+	if len(merged.Results.Itineraries) != 0 || len(merged.Sources) != 0 {
+		t.Errorf("unexpected merged results %+v", merged)
+	}
+}
@@ -0,0 +1,129 @@
+package skyscanner
+
+import "sort"
+
+const (
+	ParetoPrice    ParetoDimension = "price"    // cheapest pricing option price
+	ParetoDuration ParetoDimension = "duration" // sum of legs durations
+	ParetoStops    ParetoDimension = "stops"    // max legs stop count
+)
+
+// ParetoDimension is an itinerary criterion to minimize
+type ParetoDimension string
+
+// ParetoFrontier contains itineraries not beaten by any other itinerary on all the dimensions at once
+type ParetoFrontier struct {
+	Dimensions []ParetoDimension `json:"dimensions"`
+	// ItineraryIDs of the frontier sorted by the dimensions in their order
+	ItineraryIDs []string `json:"itineraryIds"`
+	// Dominated explains why an itinerary is excluded from the frontier
+	Dominated map[string]Dominance `json:"dominated"`
+	// Unpriced contains IDs of itineraries excluded because they have no price
+	// while the price dimension is requested
+	Unpriced []string `json:"unpriced,omitempty"`
+}
+
+// Dominance explains why an itinerary is excluded from the frontier
+type Dominance struct {
+	// DominatedBy is an ID of the frontier itinerary which is not worse on any dimension
+	DominatedBy string `json:"dominatedBy"`
+	// Better contains the dimensions where DominatedBy is strictly better
+	Better []ParetoDimension `json:"better"`
+}
+
+type paretoPoint struct {
+	id     string
+	values []float64
+}
+
+// ParetoFrontier returns the Pareto frontier of the itineraries for the dimensions.
+// Price, duration and stops are used if no dimensions are given
+func (r *Results) ParetoFrontier(dims ...ParetoDimension) *ParetoFrontier {
+	if len(dims) == 0 {
+		dims = []ParetoDimension{ParetoPrice, ParetoDuration, ParetoStops}
+	}
+
+	f := &ParetoFrontier{
+		Dimensions:   dims,
+		ItineraryIDs: make([]string, 0),
+		Dominated:    make(map[string]Dominance),
+	}
+
+	points := make([]paretoPoint, 0, len(r.Itineraries))
+	for id, it := range r.Itineraries {
+		p, ok := r.paretoPoint(id, it, dims)
+		if !ok {
+			f.Unpriced = append(f.Unpriced, id)
+			continue
+		}
+		points = append(points, p)
+	}
+	sort.Strings(f.Unpriced)
+
+	// After the lexicographical sorting an itinerary can be dominated only by the preceding ones.
+	// Domination is transitive, so it is enough to check the frontier found so far
+	sort.Slice(points, func(i, j int) bool {
+		for d := range dims {
+			if points[i].values[d] != points[j].values[d] {
+				return points[i].values[d] < points[j].values[d]
+			}
+		}
+
+		return points[i].id < points[j].id
+	})
+
+	frontier := make([]paretoPoint, 0)
+	for _, p := range points {
+		dominated := false
+		for _, fp := range frontier {
+			if better, ok := dominates(fp, p, dims); ok {
+				f.Dominated[p.id] = Dominance{DominatedBy: fp.id, Better: better}
+				dominated = true
+				break
+			}
+		}
+
+		if !dominated {
+			frontier = append(frontier, p)
+			f.ItineraryIDs = append(f.ItineraryIDs, p.id)
+		}
+	}
+
+	return f
+}
+
+func (r *Results) paretoPoint(id string, it ItineraryResult, dims []ParetoDimension) (paretoPoint, bool) {
+	p := paretoPoint{id: id, values: make([]float64, len(dims))}
+	for i, d := range dims {
+		switch d {
+		case ParetoPrice:
+			po, price := it.CheapestPricingOption()
+			if po == nil {
+				return p, false
+			}
+			p.values[i] = price
+		case ParetoDuration:
+			p.values[i] = float64(r.ItineraryDuration(it))
+		case ParetoStops:
+			p.values[i] = float64(r.ItineraryStops(it))
+		}
+	}
+
+	return p, true
+}
+
+// dominates reports whether a is not worse than b on every dimension and strictly better on at least one.
+// Returns the dimensions where a is strictly better
+func dominates(a, b paretoPoint, dims []ParetoDimension) ([]ParetoDimension, bool) {
+	var better []ParetoDimension
+	for i := range dims {
+		if a.values[i] > b.values[i] {
+			return nil, false
+		}
+		if a.values[i] < b.values[i] {
+			better = append(better, dims[i])
+		}
+	}
+
+	return better, len(better) > 0
+}
@@ -0,0 +1,101 @@
+package skyscanner
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParetoFrontier(t *testing.T) {
+	// equal to "change" on every dimension and an itinerary without a price
+	withTies := func(r *Results) {
+		r.Itineraries["copy"] = r.Itineraries["change"]
+		r.Itineraries["unpriced"] = ItineraryResult{
+			LegIds:         []string{"leg-direct"},
+			PricingOptions: []PricingOption{{AgentIds: []string{"ba"}}},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		modify        func(r *Results)
+		dims          []ParetoDimension
+		wantFrontier  []string
+		wantDominated map[string]Dominance
+		wantUnpriced  []string
+	}{
+		{
+			name:          "default dimensions",
+			wantFrontier:  []string{"change", "direct"},
+			wantDominated: map[string]Dominance{"onestop": {DominatedBy: "change", Better: []ParetoDimension{ParetoPrice, ParetoDuration}}},
+		},
+		{
+			name:         "single dimension",
+			dims:         []ParetoDimension{ParetoDuration},
+			wantFrontier: []string{"direct"},
+			wantDominated: map[string]Dominance{
+				"change":  {DominatedBy: "direct", Better: []ParetoDimension{ParetoDuration}},
+				"onestop": {DominatedBy: "direct", Better: []ParetoDimension{ParetoDuration}},
+			},
+		},
+		{
+			name:          "equal stops",
+			dims:          []ParetoDimension{ParetoPrice, ParetoStops},
+			wantFrontier:  []string{"change", "direct"},
+			wantDominated: map[string]Dominance{"onestop": {DominatedBy: "change", Better: []ParetoDimension{ParetoPrice}}},
+		},
+		{
+			name:         "stops and duration",
+			dims:         []ParetoDimension{ParetoStops, ParetoDuration},
+			wantFrontier: []string{"direct"},
+			wantDominated: map[string]Dominance{
+				"change":  {DominatedBy: "direct", Better: []ParetoDimension{ParetoStops, ParetoDuration}},
+				"onestop": {DominatedBy: "direct", Better: []ParetoDimension{ParetoStops, ParetoDuration}},
+			},
+		},
+		{
+			name:          "equal itineraries and unpriced ones",
+			modify:        withTies,
+			wantFrontier:  []string{"change", "copy", "direct"},
+			wantDominated: map[string]Dominance{"onestop": {DominatedBy: "change", Better: []ParetoDimension{ParetoPrice, ParetoDuration}}},
+			wantUnpriced:  []string{"unpriced"},
+		},
+		{
+			name:         "unpriced itineraries without the price dimension",
+			modify:       withTies,
+			dims:         []ParetoDimension{ParetoStops},
+			wantFrontier: []string{"direct", "unpriced"},
+			wantDominated: map[string]Dominance{
+				"change":  {DominatedBy: "direct", Better: []ParetoDimension{ParetoStops}},
+				"copy":    {DominatedBy: "direct", Better: []ParetoDimension{ParetoStops}},
+				"onestop": {DominatedBy: "direct", Better: []ParetoDimension{ParetoStops}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testResults()
+			if tt.modify != nil {
+				tt.modify(r)
+			}
+
+			f := r.ParetoFrontier(tt.dims...)
+			wantDims := tt.dims
+			if len(wantDims) == 0 {
+				wantDims = []ParetoDimension{ParetoPrice, ParetoDuration, ParetoStops}
+			}
+			if !reflect.DeepEqual(f.Dimensions, wantDims) {
+				t.Errorf("got dimensions %v, want %v", f.Dimensions, wantDims)
+			}
+			if !reflect.DeepEqual(f.ItineraryIDs, tt.wantFrontier) {
+				t.Errorf("got frontier %v, want %v", f.ItineraryIDs, tt.wantFrontier)
+			}
+			if !reflect.DeepEqual(f.Dominated, tt.wantDominated) {
+				t.Errorf("got dominated %+v, want %+v", f.Dominated, tt.wantDominated)
+			}
+			if !reflect.DeepEqual(f.Unpriced, tt.wantUnpriced) {
+				t.Errorf("got unpriced %v, want %v", f.Unpriced, tt.wantUnpriced)
+			}
+		})
+	}
+}
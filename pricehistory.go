@@ -0,0 +1,195 @@
+package skyscanner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// PricePoint is the cheapest price of a watch key at a moment
+type PricePoint struct {
+	WatchID string    `json:"watchId"`
+	Time    time.Time `json:"time"`
+	// Key is "total", "direct", "oneStop", "twoPlusStops" or "carrier:<carrier ID>"
+	Key string `json:"key"`
+	// Price in major currency units
+	Price float64 `json:"price"`
+}
+
+// HistoryStore stores price points of watches
+type HistoryStore interface {
+	// Append stores the points
+	Append(ctx context.Context, points []PricePoint) error
+	// History returns the points of the watch key since the time, from the oldest
+	History(ctx context.Context, watchID, key string, since time.Time) ([]PricePoint, error)
+	// Last returns the latest point of the watch key
+	Last(ctx context.Context, watchID, key string) (PricePoint, bool, error)
+}
+
+type historyKey struct {
+	watchID string
+	key     string
+}
+
+// MemoryHistoryStore keeps price history in memory
+type MemoryHistoryStore struct {
+	mu     sync.RWMutex
+	points map[historyKey][]PricePoint
+}
+
+// NewMemoryHistoryStore returns an empty in-memory history store
+func NewMemoryHistoryStore() *MemoryHistoryStore {
+	return &MemoryHistoryStore{points: make(map[historyKey][]PricePoint)}
+}
+
+// Append implements HistoryStore
+func (s *MemoryHistoryStore) Append(_ context.Context, points []PricePoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range points {
+		k := historyKey{p.WatchID, p.Key}
+		s.points[k] = append(s.points[k], p)
+	}
+
+	return nil
+}
+
+// History implements HistoryStore
+func (s *MemoryHistoryStore) History(_ context.Context, watchID, key string, since time.Time) ([]PricePoint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	history := make([]PricePoint, 0)
+	for _, p := range s.points[historyKey{watchID, key}] {
+		if !p.Time.Before(since) {
+			history = append(history, p)
+		}
+	}
+
+	return history, nil
+}
+
+// Last implements HistoryStore
+func (s *MemoryHistoryStore) Last(_ context.Context, watchID, key string) (PricePoint, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	points := s.points[historyKey{watchID, key}]
+	if len(points) == 0 {
+		return PricePoint{}, false, nil
+	}
+
+	return points[len(points)-1], true, nil
+}
+
+// FileHistoryStore keeps price history in memory and appends every point
+// to a file as a JSON line, so the history survives restarts
+type FileHistoryStore struct {
+	mem  *MemoryHistoryStore
+	mu   sync.Mutex
+	file *os.File
+	// size is the length of the complete lines in the file
+	size int64
+}
+
+// OpenFileHistoryStore loads the history from the file and opens it for appending.
+// The file is created if it does not exist. A partial last line left by an interrupted append is dropped
+func OpenFileHistoryStore(path string) (*FileHistoryStore, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	mem := NewMemoryHistoryStore()
+	r := bufio.NewReader(f)
+	var size int64
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			_ = f.Close()
+			return nil, err
+		}
+
+		if len(bytes.TrimSpace(line)) > 0 {
+			var p PricePoint
+			if err := json.Unmarshal(line, &p); err != nil {
+				_ = f.Close()
+				return nil, errors.New("price history decoding error: " + err.Error())
+			}
+			_ = mem.Append(context.Background(), []PricePoint{p})
+		}
+		size += int64(len(line))
+	}
+
+	s := &FileHistoryStore{mem: mem, file: f, size: size}
+	if err := s.truncatePartial(); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Append implements HistoryStore. The points are written at once and kept in memory only if the write succeeds
+func (s *FileHistoryStore) Append(ctx context.Context, points []PricePoint) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, p := range points {
+		if err := enc.Encode(p); err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, err := s.file.Write(buf.Bytes())
+	if err != nil {
+		_ = s.truncatePartial()
+		return err
+	}
+	s.size += int64(n)
+
+	return s.mem.Append(ctx, points)
+}
+
+// truncatePartial drops the bytes written after the last complete line
+func (s *FileHistoryStore) truncatePartial() error {
+	info, err := s.file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() == s.size {
+		return nil
+	}
+
+	return s.file.Truncate(s.size)
+}
+
+// History implements HistoryStore
+func (s *FileHistoryStore) History(ctx context.Context, watchID, key string, since time.Time) ([]PricePoint, error) {
+	return s.mem.History(ctx, watchID, key, since)
+}
+
+// Last implements HistoryStore
+func (s *FileHistoryStore) Last(ctx context.Context, watchID, key string) (PricePoint, bool, error) {
+	return s.mem.Last(ctx, watchID, key)
+}
+
+// Close closes the file
+func (s *FileHistoryStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
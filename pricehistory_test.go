@@ -0,0 +1,102 @@
+package skyscanner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	historyLine1 = `{"watchId":"w","time":"2024-11-03T10:00:00Z","key":"total","price":120}` + "\n"
+	historyLine2 = `{"watchId":"w","time":"2024-11-03T11:00:00Z","key":"total","price":110}` + "\n"
+)
+
+func TestOpenFileHistoryStore(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		wantErr  bool
+		wantLen  int
+		wantFile string
+	}{
+		{name: "empty file", wantFile: ""},
+		{name: "complete lines", content: historyLine1 + "\n" + historyLine2, wantLen: 2, wantFile: historyLine1 + "\n" + historyLine2},
+		{name: "partial last line", content: historyLine1 + historyLine2[:30], wantLen: 1, wantFile: historyLine1},
+		{name: "last line without newline", content: historyLine1 + strings.TrimSpace(historyLine2), wantLen: 1, wantFile: historyLine1},
+		{name: "corrupted line", content: historyLine1[:30] + "\n" + historyLine2, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "history.jsonl")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			s, err := OpenFileHistoryStore(path)
+			if tt.wantErr {
+				if err == nil {
+					_ = s.Close()
+					t.Fatal("corrupted history is loaded")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer s.Close()
+
+			history, _ := s.History(context.Background(), "w", "total", time.Time{})
+			if len(history) != tt.wantLen {
+				t.Errorf("loaded %d points, want %d", len(history), tt.wantLen)
+			}
+			if b, _ := os.ReadFile(path); string(b) != tt.wantFile {
+				t.Errorf("file is %q, want %q", b, tt.wantFile)
+			}
+		})
+	}
+}
+
+func TestFileHistoryStoreAppend(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	if err := os.WriteFile(path, []byte(historyLine1+historyLine2[:30]), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := OpenFileHistoryStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := time.Date(2024, 11, 3, 12, 0, 0, 0, time.UTC)
+	points := []PricePoint{
+		{WatchID: "w", Time: at, Key: PriceKeyTotal, Price: 100},
+		{WatchID: "w", Time: at, Key: CarrierPriceKey("-32732"), Price: 105},
+	}
+	if err := s.Append(ctx, points); err != nil {
+		t.Fatal(err)
+	}
+	_ = s.Close()
+
+	if err := s.Append(ctx, points[:1]); err == nil {
+		t.Error("append to the closed file succeeded")
+	}
+	if last, _, _ := s.Last(ctx, "w", PriceKeyTotal); last.Price != 100 {
+		t.Errorf("failed append is kept in memory: %+v", last)
+	}
+
+	reopened, err := OpenFileHistoryStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+
+	total, _ := reopened.History(ctx, "w", PriceKeyTotal, time.Time{})
+	carrier, _ := reopened.History(ctx, "w", CarrierPriceKey("-32732"), time.Time{})
+	if len(total) != 2 || total[1].Price != 100 || len(carrier) != 1 {
+		t.Errorf("reloaded total %+v and carrier %+v", total, carrier)
+	}
+}
@@ -0,0 +1,212 @@
+package skyscanner
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+const (
+	PriceKeyTotal        = "total"
+	PriceKeyDirect       = "direct"
+	PriceKeyOneStop      = "oneStop"
+	PriceKeyTwoPlusStops = "twoPlusStops"
+	priceKeyCarrier      = "carrier:"
+)
+
+// PriceThreshold describes when a price alert fires
+type PriceThreshold struct {
+	// Key is a price key to watch. Default is PriceKeyTotal. See PricePoint.Key
+	Key string `json:"key"`
+	// DropPercent fires the alert when the price dropped by at least the percent since the previous check
+	DropPercent float64 `json:"dropPercent,omitempty"`
+	// Below fires the alert when the price falls below the amount in major currency units
+	Below float64 `json:"below,omitempty"`
+}
+
+// PriceAlert is fired when a threshold is crossed
+type PriceAlert struct {
+	WatchID   string         `json:"watchId"`
+	Threshold PriceThreshold `json:"threshold"`
+	Previous  *PricePoint    `json:"previous,omitempty"`
+	Current   PricePoint     `json:"current"`
+}
+
+// PriceWatch periodically runs a saved search and stores the cheapest prices
+// per stop bucket and per carrier in the history store
+type PriceWatch struct {
+	ID       string
+	Client   Client
+	Request  *CreateRequest
+	Interval time.Duration
+	Store    HistoryStore
+	// Thresholds are checked after every search
+	Thresholds []PriceThreshold
+	// OnAlert is called for every crossed threshold
+	OnAlert func(PriceAlert)
+	// OnError is called when a check fails during Run
+	OnError func(error)
+}
+
+// Run checks prices immediately and then every interval until the context is done
+func (w *PriceWatch) Run(ctx context.Context) error {
+	if w.Interval <= 0 {
+		return errors.New("price watch interval must be positive")
+	}
+
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.Check(ctx); err != nil && w.OnError != nil {
+			w.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check runs the search once, stores the prices and returns the crossed thresholds.
+// OnAlert is called for each of them
+func (w *PriceWatch) Check(ctx context.Context) ([]PriceAlert, error) {
+	resp, errResp := Search(ctx, w.Client, w.Request)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	points := PricePoints(w.ID, time.Now(), resp)
+	previous := make(map[string]PricePoint, len(w.Thresholds))
+	for _, t := range w.Thresholds {
+		key := t.key()
+		if _, ok := previous[key]; ok {
+			continue
+		}
+
+		p, ok, err := w.Store.Last(ctx, w.ID, key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			previous[key] = p
+		}
+	}
+
+	if err := w.Store.Append(ctx, points); err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]PricePoint, len(points))
+	for _, p := range points {
+		current[p.Key] = p
+	}
+
+	alerts := make([]PriceAlert, 0)
+	for _, t := range w.Thresholds {
+		cur, ok := current[t.key()]
+		if !ok {
+			continue
+		}
+
+		var prev *PricePoint
+		if p, ok := previous[t.key()]; ok {
+			prev = &p
+		}
+
+		if t.crossed(prev, cur) {
+			alert := PriceAlert{WatchID: w.ID, Threshold: t, Previous: prev, Current: cur}
+			alerts = append(alerts, alert)
+			if w.OnAlert != nil {
+				w.OnAlert(alert)
+			}
+		}
+	}
+
+	return alerts, nil
+}
+
+// PricePoints returns the cheapest prices of the response per stop bucket and per marketing carrier.
+// Stop buckets are taken from the response stats or computed if there are none
+func PricePoints(watchID string, at time.Time, resp *CreatePollResponse) []PricePoint {
+	r := responseResults(resp)
+	stats := responseStats(resp, r)
+
+	points := make([]PricePoint, 0)
+	for _, b := range []struct {
+		key     string
+		summary ItinerarySummary
+	}{
+		{PriceKeyTotal, stats.Itineraries.Total},
+		{PriceKeyDirect, stats.Itineraries.Stops.Direct.Total},
+		{PriceKeyOneStop, stats.Itineraries.Stops.OneStop.Total},
+		{PriceKeyTwoPlusStops, stats.Itineraries.Stops.TwoPlusStops.Total},
+	} {
+		if b.summary.MinPrice.Amount == "" {
+			continue
+		}
+		price, err := b.summary.MinPrice.ToFloat()
+		if err != nil {
+			continue
+		}
+
+		points = append(points, PricePoint{WatchID: watchID, Time: at, Key: b.key, Price: price})
+	}
+
+	carriers := make(map[string]float64)
+	for _, it := range r.Itineraries {
+		po, price := it.CheapestPricingOption()
+		if po == nil {
+			continue
+		}
+
+		for _, id := range r.ItineraryCarrierIDs(it) {
+			if current, ok := carriers[id]; !ok || price < current {
+				carriers[id] = price
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(carriers))
+	for id := range carriers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		points = append(points, PricePoint{WatchID: watchID, Time: at, Key: CarrierPriceKey(id), Price: carriers[id]})
+	}
+
+	return points
+}
+
+// CarrierPriceKey returns the price key of the carrier
+func CarrierPriceKey(carrierID string) string {
+	return priceKeyCarrier + carrierID
+}
+
+func (t PriceThreshold) key() string {
+	if t.Key == "" {
+		return PriceKeyTotal
+	}
+
+	return t.Key
+}
+
+// crossed reports whether the price crossed the threshold since the previous point
+func (t PriceThreshold) crossed(prev *PricePoint, cur PricePoint) bool {
+	if t.Below > 0 && cur.Price < t.Below && (prev == nil || prev.Price >= t.Below) {
+		return true
+	}
+
+	if t.DropPercent > 0 && prev != nil && prev.Price > 0 {
+		drop := (prev.Price - cur.Price) / prev.Price * 100
+		if drop >= t.DropPercent {
+			return true
+		}
+	}
+
+	return false
+}
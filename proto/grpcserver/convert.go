@@ -0,0 +1,868 @@
+package grpcserver
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/VitaliyJ/skyscanner"
+	pb "github.com/VitaliyJ/skyscanner/proto/skyscanner/v1"
+)
+
+// protoEnum is implemented by the generated enums
+type protoEnum interface {
+	String() string
+	Number() protoreflect.EnumNumber
+}
+
+// enumToProto converts an SDK constant to the enum value of the same name.
+// Empty and unknown names become the unspecified zero value
+func enumToProto[E ~int32](name string, values map[string]int32) E {
+	return E(values[name])
+}
+
+// enumFromProto converts an enum value to the SDK constant of the same name.
+// The unspecified zero value becomes an empty string, the SDK zero value
+func enumFromProto[T ~string](e protoEnum) T {
+	if e.Number() == 0 {
+		return ""
+	}
+
+	return T(e.String())
+}
+
+// SearchRequestFromProto converts a gRPC search request to the SDK create request
+func SearchRequestFromProto(req *pb.SearchRequest) *skyscanner.CreateRequest {
+	if req == nil {
+		return nil
+	}
+
+	return &skyscanner.CreateRequest{Query: queryFromProto(req.Query)}
+}
+
+// SearchRequestToProto converts the SDK create request to a gRPC search request
+func SearchRequestToProto(req *skyscanner.CreateRequest) *pb.SearchRequest {
+	if req == nil {
+		return nil
+	}
+
+	return &pb.SearchRequest{Query: queryToProto(req.Query)}
+}
+
+// SearchResponseToProto converts a create or poll response. The session token is not exposed
+func SearchResponseToProto(resp *skyscanner.CreatePollResponse) *pb.SearchResponse {
+	if resp == nil {
+		return nil
+	}
+
+	return &pb.SearchResponse{
+		Status:  enumToProto[pb.ResultStatus](string(resp.Status), pb.ResultStatus_value),
+		Action:  enumToProto[pb.ResultAction](string(resp.Action), pb.ResultAction_value),
+		Content: contentToProto(resp.Content),
+	}
+}
+
+// SearchResponseFromProto converts a gRPC search response to a create or poll response without the session token
+func SearchResponseFromProto(resp *pb.SearchResponse) *skyscanner.CreatePollResponse {
+	if resp == nil {
+		return nil
+	}
+
+	return &skyscanner.CreatePollResponse{
+		Status:  enumFromProto[skyscanner.ResponseStatus](resp.Status),
+		Action:  enumFromProto[skyscanner.ResponseAction](resp.Action),
+		Content: contentFromProto(resp.Content),
+	}
+}
+
+// AutoSuggestRequestFromProto converts a gRPC autosuggest request to the SDK one
+func AutoSuggestRequestFromProto(req *pb.AutoSuggestFlightsRequest) *skyscanner.AutoSuggestFlightsRequest {
+	if req == nil {
+		return nil
+	}
+
+	types := make([]skyscanner.PlaceType, 0, len(req.IncludedEntityTypes))
+	for _, t := range req.IncludedEntityTypes {
+		types = append(types, enumFromProto[skyscanner.PlaceType](t))
+	}
+
+	return &skyscanner.AutoSuggestFlightsRequest{
+		Query: skyscanner.AutoSuggestFlightsRequestQuery{
+			Locale:              req.Locale,
+			Market:              req.Market,
+			SearchTerm:          req.SearchTerm,
+			IncludedEntityTypes: types,
+		},
+		Limit:         req.Limit,
+		IsDestination: req.IsDestination,
+	}
+}
+
+// AutoSuggestRequestToProto converts the SDK autosuggest request to a gRPC one
+func AutoSuggestRequestToProto(req *skyscanner.AutoSuggestFlightsRequest) *pb.AutoSuggestFlightsRequest {
+	if req == nil {
+		return nil
+	}
+
+	types := make([]pb.PlaceType, 0, len(req.Query.IncludedEntityTypes))
+	for _, t := range req.Query.IncludedEntityTypes {
+		types = append(types, enumToProto[pb.PlaceType](string(t), pb.PlaceType_value))
+	}
+
+	return &pb.AutoSuggestFlightsRequest{
+		Locale:              req.Query.Locale,
+		Market:              req.Query.Market,
+		SearchTerm:          req.Query.SearchTerm,
+		IncludedEntityTypes: types,
+		Limit:               req.Limit,
+		IsDestination:       req.IsDestination,
+	}
+}
+
+// AutoSuggestResponseToProto converts the SDK autosuggest response
+func AutoSuggestResponseToProto(resp *skyscanner.AutoSuggestFlightsResponse) *pb.AutoSuggestFlightsResponse {
+	if resp == nil {
+		return nil
+	}
+
+	places := make([]*pb.AutoSuggestPlace, 0, len(resp.Places))
+	for _, p := range resp.Places {
+		if p != nil {
+			places = append(places, autoSuggestPlaceToProto(*p))
+		}
+	}
+
+	return &pb.AutoSuggestFlightsResponse{Places: places}
+}
+
+// AutoSuggestResponseFromProto converts a gRPC autosuggest response
+func AutoSuggestResponseFromProto(resp *pb.AutoSuggestFlightsResponse) *skyscanner.AutoSuggestFlightsResponse {
+	if resp == nil {
+		return nil
+	}
+
+	places := make([]*skyscanner.AutoSuggestPlace, 0, len(resp.Places))
+	for _, p := range resp.Places {
+		place := autoSuggestPlaceFromProto(p)
+		places = append(places, &place)
+	}
+
+	return &skyscanner.AutoSuggestFlightsResponse{Places: places}
+}
+
+// LocalesResponseToProto converts the SDK locales response
+func LocalesResponseToProto(resp *skyscanner.LocalesResponse) *pb.LocalesResponse {
+	if resp == nil {
+		return nil
+	}
+
+	locales := make([]*pb.Locale, 0, len(resp.Locales))
+	for _, l := range resp.Locales {
+		locales = append(locales, localeToProto(l))
+	}
+
+	return &pb.LocalesResponse{
+		Status:  enumToProto[pb.ResultStatus](string(resp.Status), pb.ResultStatus_value),
+		Locales: locales,
+	}
+}
+
+// LocalesResponseFromProto converts a gRPC locales response
+func LocalesResponseFromProto(resp *pb.LocalesResponse) *skyscanner.LocalesResponse {
+	if resp == nil {
+		return nil
+	}
+
+	locales := make([]skyscanner.Locale, 0, len(resp.Locales))
+	for _, l := range resp.Locales {
+		locales = append(locales, localeFromProto(l))
+	}
+
+	return &skyscanner.LocalesResponse{
+		Status:  enumFromProto[skyscanner.ResponseStatus](resp.Status),
+		Locales: locales,
+	}
+}
+
+// CurrenciesResponseToProto converts the SDK currencies response
+func CurrenciesResponseToProto(resp *skyscanner.CurrenciesResponse) *pb.CurrenciesResponse {
+	if resp == nil {
+		return nil
+	}
+
+	currencies := make([]*pb.Currency, 0, len(resp.Currencies))
+	for _, c := range resp.Currencies {
+		currencies = append(currencies, currencyToProto(c))
+	}
+
+	return &pb.CurrenciesResponse{
+		Status:     enumToProto[pb.ResultStatus](string(resp.Status), pb.ResultStatus_value),
+		Currencies: currencies,
+	}
+}
+
+// CurrenciesResponseFromProto converts a gRPC currencies response
+func CurrenciesResponseFromProto(resp *pb.CurrenciesResponse) *skyscanner.CurrenciesResponse {
+	if resp == nil {
+		return nil
+	}
+
+	currencies := make([]skyscanner.Currency, 0, len(resp.Currencies))
+	for _, c := range resp.Currencies {
+		currencies = append(currencies, currencyFromProto(c))
+	}
+
+	return &skyscanner.CurrenciesResponse{
+		Status:     enumFromProto[skyscanner.ResponseStatus](resp.Status),
+		Currencies: currencies,
+	}
+}
+
+// MarketsResponseToProto converts the SDK markets response
+func MarketsResponseToProto(resp *skyscanner.MarketsResponse) *pb.MarketsResponse {
+	if resp == nil {
+		return nil
+	}
+
+	markets := make([]*pb.Market, 0, len(resp.Markets))
+	for _, m := range resp.Markets {
+		markets = append(markets, marketToProto(m))
+	}
+
+	return &pb.MarketsResponse{
+		Status:  enumToProto[pb.ResultStatus](string(resp.Status), pb.ResultStatus_value),
+		Markets: markets,
+	}
+}
+
+// MarketsResponseFromProto converts a gRPC markets response
+func MarketsResponseFromProto(resp *pb.MarketsResponse) *skyscanner.MarketsResponse {
+	if resp == nil {
+		return nil
+	}
+
+	markets := make([]skyscanner.Market, 0, len(resp.Markets))
+	for _, m := range resp.Markets {
+		markets = append(markets, marketFromProto(m))
+	}
+
+	return &skyscanner.MarketsResponse{
+		Status:  enumFromProto[skyscanner.ResponseStatus](resp.Status),
+		Markets: markets,
+	}
+}
+
+// NearestCultureResponseToProto converts the SDK nearest culture response
+func NearestCultureResponseToProto(resp *skyscanner.NearestCultureResponse) *pb.NearestCultureResponse {
+	if resp == nil {
+		return nil
+	}
+
+	return &pb.NearestCultureResponse{
+		Status:   enumToProto[pb.ResultStatus](string(resp.Status), pb.ResultStatus_value),
+		Market:   marketToProto(resp.Market),
+		Locale:   localeToProto(resp.Locale),
+		Currency: currencyToProto(resp.Currency),
+	}
+}
+
+// NearestCultureResponseFromProto converts a gRPC nearest culture response
+func NearestCultureResponseFromProto(resp *pb.NearestCultureResponse) *skyscanner.NearestCultureResponse {
+	if resp == nil {
+		return nil
+	}
+
+	return &skyscanner.NearestCultureResponse{
+		Status:   enumFromProto[skyscanner.ResponseStatus](resp.Status),
+		Market:   marketFromProto(resp.Market),
+		Locale:   localeFromProto(resp.Locale),
+		Currency: currencyFromProto(resp.Currency),
+	}
+}
+
+func queryToProto(q *skyscanner.CreateRequestQuery) *pb.CreateRequestQuery {
+	if q == nil {
+		return nil
+	}
+
+	legs := make([]*pb.QueryLeg, 0, len(q.QueryLegs))
+	for _, l := range q.QueryLegs {
+		if l != nil {
+			legs = append(legs, queryLegToProto(*l))
+		}
+	}
+	ages := make([]int32, 0, len(q.ChildrenAges))
+	for _, a := range q.ChildrenAges {
+		ages = append(ages, int32(a))
+	}
+
+	return &pb.CreateRequestQuery{
+		Market:                    q.Market,
+		Locale:                    q.Locale,
+		Currency:                  q.Currency,
+		QueryLegs:                 legs,
+		Adults:                    q.Adults,
+		IncludedCarriersIds:       q.IncludedCarriersIds,
+		CabinClass:                enumToProto[pb.CabinClass](string(q.CabinClass), pb.CabinClass_value),
+		ChildrenAges:              ages,
+		ExcludedCarriersIds:       q.ExcludedCarriersIds,
+		IncludedAgentsIds:         q.IncludedAgentsIds,
+		ExcludedAgentsIds:         q.ExcludedAgentsIds,
+		IncludeSustainabilityData: q.IncludeSustainabilityData,
+		NearbyAirports:            q.NearbyAirports,
+	}
+}
+
+func queryFromProto(q *pb.CreateRequestQuery) *skyscanner.CreateRequestQuery {
+	if q == nil {
+		return nil
+	}
+
+	legs := make([]*skyscanner.QueryLeg, 0, len(q.QueryLegs))
+	for _, l := range q.QueryLegs {
+		legs = append(legs, queryLegFromProto(l))
+	}
+	var ages []int
+	for _, a := range q.ChildrenAges {
+		ages = append(ages, int(a))
+	}
+
+	return &skyscanner.CreateRequestQuery{
+		Market:                    q.Market,
+		Locale:                    q.Locale,
+		Currency:                  q.Currency,
+		QueryLegs:                 legs,
+		Adults:                    q.Adults,
+		IncludedCarriersIds:       q.IncludedCarriersIds,
+		CabinClass:                enumFromProto[skyscanner.CabinClass](q.CabinClass),
+		ChildrenAges:              ages,
+		ExcludedCarriersIds:       q.ExcludedCarriersIds,
+		IncludedAgentsIds:         q.IncludedAgentsIds,
+		ExcludedAgentsIds:         q.ExcludedAgentsIds,
+		IncludeSustainabilityData: q.IncludeSustainabilityData,
+		NearbyAirports:            q.NearbyAirports,
+	}
+}
+
+func queryLegToProto(l skyscanner.QueryLeg) *pb.QueryLeg {
+	leg := &pb.QueryLeg{}
+	if l.OriginPlaceId != nil {
+		leg.OriginPlaceId = &pb.PlaceId{Iata: l.OriginPlaceId.IATA, EntityId: l.OriginPlaceId.EntityId}
+	}
+	if l.DestinationPlaceId != nil {
+		leg.DestinationPlaceId = &pb.PlaceId{Iata: l.DestinationPlaceId.IATA, EntityId: l.DestinationPlaceId.EntityId}
+	}
+	if l.Date != nil {
+		leg.Date = datetimeToProto(*l.Date)
+	}
+
+	return leg
+}
+
+func queryLegFromProto(l *pb.QueryLeg) *skyscanner.QueryLeg {
+	leg := &skyscanner.QueryLeg{}
+	if l.GetOriginPlaceId() != nil {
+		leg.OriginPlaceId = &skyscanner.PlaceID{IATA: l.OriginPlaceId.Iata, EntityId: l.OriginPlaceId.EntityId}
+	}
+	if l.GetDestinationPlaceId() != nil {
+		leg.DestinationPlaceId = &skyscanner.PlaceID{IATA: l.DestinationPlaceId.Iata, EntityId: l.DestinationPlaceId.EntityId}
+	}
+	if l.GetDate() != nil {
+		d := datetimeFromProto(l.Date)
+		leg.Date = &d
+	}
+
+	return leg
+}
+
+func datetimeToProto(d skyscanner.LocalDatetime) *pb.LocalDatetime {
+	return &pb.LocalDatetime{Year: d.Year, Month: d.Month, Day: d.Day, Hour: d.Hour, Minute: d.Minute, Second: d.Second}
+}
+
+func datetimeFromProto(d *pb.LocalDatetime) skyscanner.LocalDatetime {
+	return skyscanner.LocalDatetime{
+		Year:   d.GetYear(),
+		Month:  d.GetMonth(),
+		Day:    d.GetDay(),
+		Hour:   d.GetHour(),
+		Minute: d.GetMinute(),
+		Second: d.GetSecond(),
+	}
+}
+
+func priceToProto(p skyscanner.Price) *pb.Price {
+	return &pb.Price{Amount: p.Amount, Unit: enumToProto[pb.PriceUnit](string(p.Unit), pb.PriceUnit_value)}
+}
+
+func priceFromProto(p *pb.Price) skyscanner.Price {
+	return skyscanner.Price{Amount: p.GetAmount(), Unit: enumFromProto[skyscanner.PriceUnit](p.GetUnit())}
+}
+
+func contentToProto(c *skyscanner.Content) *pb.Content {
+	if c == nil {
+		return nil
+	}
+
+	content := &pb.Content{Results: resultsToProto(c.Results)}
+	if c.Stats != nil {
+		content.Stats = statsToProto(*c.Stats)
+	}
+	if c.SortingOptions != nil {
+		content.SortingOptions = sortingOptionsToProto(*c.SortingOptions)
+	}
+
+	return content
+}
+
+func contentFromProto(c *pb.Content) *skyscanner.Content {
+	if c == nil {
+		return nil
+	}
+
+	content := &skyscanner.Content{Results: resultsFromProto(c.Results)}
+	if c.Stats != nil {
+		stats := statsFromProto(c.Stats)
+		content.Stats = &stats
+	}
+	if c.SortingOptions != nil {
+		sorting := sortingOptionsFromProto(c.SortingOptions)
+		content.SortingOptions = &sorting
+	}
+
+	return content
+}
+
+// convertMap converts map values keeping the keys
+func convertMap[K comparable, V, R any](m map[K]V, convert func(V) R) map[K]R {
+	if m == nil {
+		return nil
+	}
+
+	out := make(map[K]R, len(m))
+	for k, v := range m {
+		out[k] = convert(v)
+	}
+
+	return out
+}
+
+// convertSlice converts slice elements keeping nil slices nil
+func convertSlice[V, R any](s []V, convert func(V) R) []R {
+	if s == nil {
+		return nil
+	}
+
+	out := make([]R, 0, len(s))
+	for _, v := range s {
+		out = append(out, convert(v))
+	}
+
+	return out
+}
+
+func resultsToProto(r *skyscanner.Results) *pb.Results {
+	if r == nil {
+		return nil
+	}
+
+	return &pb.Results{
+		Itineraries: convertMap(r.Itineraries, itineraryToProto),
+		Legs:        convertMap(r.Legs, legToProto),
+		Segments:    convertMap(r.Segments, segmentToProto),
+		Places:      convertMap(r.Places, placeToProto),
+		Carriers:    convertMap(r.Carriers, carrierToProto),
+		Agents:      convertMap(r.Agents, agentToProto),
+		Alliances:   convertMap(r.Alliances, allianceToProto),
+	}
+}
+
+func resultsFromProto(r *pb.Results) *skyscanner.Results {
+	if r == nil {
+		return nil
+	}
+
+	return &skyscanner.Results{
+		Itineraries: convertMap(r.Itineraries, itineraryFromProto),
+		Legs:        convertMap(r.Legs, legFromProto),
+		Segments:    convertMap(r.Segments, segmentFromProto),
+		Places:      convertMap(r.Places, placeFromProto),
+		Carriers:    convertMap(r.Carriers, carrierFromProto),
+		Agents:      convertMap(r.Agents, agentFromProto),
+		Alliances:   convertMap(r.Alliances, allianceFromProto),
+	}
+}
+
+func itineraryToProto(it skyscanner.ItineraryResult) *pb.ItineraryResult {
+	return &pb.ItineraryResult{
+		PricingOptions: convertSlice(it.PricingOptions, pricingOptionToProto),
+		LegIds:         it.LegIds,
+		SustainabilityData: &pb.SustainabilityData{
+			IsEcoContender:    it.SustainabilityData.IsEcoContender,
+			EcoContenderDelta: it.SustainabilityData.EcoContenderDelta,
+		},
+	}
+}
+
+func itineraryFromProto(it *pb.ItineraryResult) skyscanner.ItineraryResult {
+	return skyscanner.ItineraryResult{
+		PricingOptions: convertSlice(it.GetPricingOptions(), pricingOptionFromProto),
+		LegIds:         it.GetLegIds(),
+		SustainabilityData: skyscanner.SustainabilityData{
+			IsEcoContender:    it.GetSustainabilityData().GetIsEcoContender(),
+			EcoContenderDelta: it.GetSustainabilityData().GetEcoContenderDelta(),
+		},
+	}
+}
+
+func pricingOptionToProto(po skyscanner.PricingOption) *pb.PricingOption {
+	return &pb.PricingOption{
+		Price:        priceToProto(po.Price),
+		AgentIds:     po.AgentIds,
+		Items:        convertSlice(po.Items, pricingOptionItemToProto),
+		TransferType: enumToProto[pb.TransferType](string(po.TransferType), pb.TransferType_value),
+	}
+}
+
+func pricingOptionFromProto(po *pb.PricingOption) skyscanner.PricingOption {
+	return skyscanner.PricingOption{
+		Price:        priceFromProto(po.GetPrice()),
+		AgentIds:     po.GetAgentIds(),
+		Items:        convertSlice(po.GetItems(), pricingOptionItemFromProto),
+		TransferType: enumFromProto[skyscanner.TransferType](po.GetTransferType()),
+	}
+}
+
+func pricingOptionItemToProto(item skyscanner.LivePricingOptionItem) *pb.PricingOptionItem {
+	return &pb.PricingOptionItem{
+		Price:    priceToProto(item.Price),
+		AgentId:  item.AgentID,
+		DeepLink: item.DeepLink,
+		Fares: convertSlice(item.Fares, func(f skyscanner.LivePricingOptionItemFares) *pb.PricingOptionFare {
+			return &pb.PricingOptionFare{SegmentId: f.SegmentID, BookingCode: f.BookingCode, FareBasisCode: f.FareBasisCode}
+		}),
+	}
+}
+
+func pricingOptionItemFromProto(item *pb.PricingOptionItem) skyscanner.LivePricingOptionItem {
+	return skyscanner.LivePricingOptionItem{
+		Price:    priceFromProto(item.GetPrice()),
+		AgentID:  item.GetAgentId(),
+		DeepLink: item.GetDeepLink(),
+		Fares: convertSlice(item.GetFares(), func(f *pb.PricingOptionFare) skyscanner.LivePricingOptionItemFares {
+			return skyscanner.LivePricingOptionItemFares{
+				SegmentID:     f.GetSegmentId(),
+				BookingCode:   f.GetBookingCode(),
+				FareBasisCode: f.GetFareBasisCode(),
+			}
+		}),
+	}
+}
+
+func legToProto(l skyscanner.FlightLeg) *pb.FlightLeg {
+	return &pb.FlightLeg{
+		OriginPlaceId:       l.OriginPlaceID,
+		DestinationPlaceId:  l.DestinationPlaceID,
+		DepartureDateTime:   datetimeToProto(l.DepartureDateTime),
+		ArrivalDateTime:     datetimeToProto(l.ArrivalDateTime),
+		DurationInMinutes:   l.DurationInMinutes,
+		StopCount:           l.StopCount,
+		MarketingCarrierIds: l.MarketingCarrierIds,
+		OperatingCarrierIds: l.OperatingCarrierIds,
+		SegmentIds:          l.SegmentIds,
+	}
+}
+
+func legFromProto(l *pb.FlightLeg) skyscanner.FlightLeg {
+	return skyscanner.FlightLeg{
+		OriginPlaceID:       l.GetOriginPlaceId(),
+		DestinationPlaceID:  l.GetDestinationPlaceId(),
+		DepartureDateTime:   datetimeFromProto(l.GetDepartureDateTime()),
+		ArrivalDateTime:     datetimeFromProto(l.GetArrivalDateTime()),
+		DurationInMinutes:   l.GetDurationInMinutes(),
+		StopCount:           l.GetStopCount(),
+		MarketingCarrierIds: l.GetMarketingCarrierIds(),
+		OperatingCarrierIds: l.GetOperatingCarrierIds(),
+		SegmentIds:          l.GetSegmentIds(),
+	}
+}
+
+func segmentToProto(s skyscanner.Segment) *pb.Segment {
+	return &pb.Segment{
+		OriginPlaceId:         s.OriginPlaceID,
+		DestinationPlaceId:    s.DestinationPlaceID,
+		DepartureDateTime:     datetimeToProto(s.DepartureDateTime),
+		ArrivalDateTime:       datetimeToProto(s.ArrivalDateTime),
+		DurationInMinutes:     s.DurationInMinutes,
+		MarketingFlightNumber: s.MarketingFlightNumber,
+		MarketingCarrierId:    s.MarketingCarrierId,
+		OperatingCarrierId:    s.OperatingCarrierId,
+	}
+}
+
+func segmentFromProto(s *pb.Segment) skyscanner.Segment {
+	return skyscanner.Segment{
+		OriginPlaceID:         s.GetOriginPlaceId(),
+		DestinationPlaceID:    s.GetDestinationPlaceId(),
+		DepartureDateTime:     datetimeFromProto(s.GetDepartureDateTime()),
+		ArrivalDateTime:       datetimeFromProto(s.GetArrivalDateTime()),
+		DurationInMinutes:     s.GetDurationInMinutes(),
+		MarketingFlightNumber: s.GetMarketingFlightNumber(),
+		MarketingCarrierId:    s.GetMarketingCarrierId(),
+		OperatingCarrierId:    s.GetOperatingCarrierId(),
+	}
+}
+
+func placeToProto(p skyscanner.Place) *pb.Place {
+	return &pb.Place{
+		EntityId: p.EntityId,
+		ParentId: p.ParentId,
+		Name:     p.Name,
+		Type:     enumToProto[pb.PlaceType](string(p.Type), pb.PlaceType_value),
+		Iata:     p.IATA,
+	}
+}
+
+func placeFromProto(p *pb.Place) skyscanner.Place {
+	return skyscanner.Place{
+		EntityId: p.GetEntityId(),
+		ParentId: p.GetParentId(),
+		Name:     p.GetName(),
+		Type:     enumFromProto[skyscanner.PlaceType](p.GetType()),
+		IATA:     p.GetIata(),
+	}
+}
+
+func carrierToProto(c skyscanner.Carrier) *pb.Carrier {
+	return &pb.Carrier{Name: c.Name, AllianceId: c.AllianceID, ImageUrl: c.ImageURL, Iata: c.IATA}
+}
+
+func carrierFromProto(c *pb.Carrier) skyscanner.Carrier {
+	return skyscanner.Carrier{Name: c.GetName(), AllianceID: c.GetAllianceId(), ImageURL: c.GetImageUrl(), IATA: c.GetIata()}
+}
+
+func agentToProto(a skyscanner.Agent) *pb.Agent {
+	return &pb.Agent{
+		Name:          a.Name,
+		Type:          enumToProto[pb.AgentType](string(a.Type), pb.AgentType_value),
+		ImageUrl:      a.ImageURL,
+		FeedbackCount: a.FeedbackCount,
+		Rating:        a.Rating,
+		RatingBreakdown: &pb.AgentRatingBreakdown{
+			CustomerService: a.RatingBreakdown.CustomerService,
+			ReliablePrices:  a.RatingBreakdown.ReliablePrices,
+			ClearExtraFees:  a.RatingBreakdown.ClearExtraFees,
+			EaseOfBooking:   a.RatingBreakdown.EaseOfBooking,
+			Other:           a.RatingBreakdown.Other,
+		},
+		IsOptimisedForMobile: a.IsOptimisedForMobile,
+	}
+}
+
+func agentFromProto(a *pb.Agent) skyscanner.Agent {
+	rb := a.GetRatingBreakdown()
+	return skyscanner.Agent{
+		Name:          a.GetName(),
+		Type:          enumFromProto[skyscanner.AgentType](a.GetType()),
+		ImageURL:      a.GetImageUrl(),
+		FeedbackCount: a.GetFeedbackCount(),
+		Rating:        a.GetRating(),
+		RatingBreakdown: skyscanner.AgentRatingBreakdown{
+			CustomerService: rb.GetCustomerService(),
+			ReliablePrices:  rb.GetReliablePrices(),
+			ClearExtraFees:  rb.GetClearExtraFees(),
+			EaseOfBooking:   rb.GetEaseOfBooking(),
+			Other:           rb.GetOther(),
+		},
+		IsOptimisedForMobile: a.GetIsOptimisedForMobile(),
+	}
+}
+
+func allianceToProto(a skyscanner.Alliance) *pb.Alliance {
+	return &pb.Alliance{Name: a.Name}
+}
+
+func allianceFromProto(a *pb.Alliance) skyscanner.Alliance {
+	return skyscanner.Alliance{Name: a.GetName()}
+}
+
+func statsToProto(s skyscanner.Stats) *pb.Stats {
+	its := s.Itineraries
+	return &pb.Stats{Itineraries: &pb.ItineraryStats{
+		MinDuration: its.MinDuration,
+		MaxDuration: its.MaxDuration,
+		Total:       summaryToProto(its.Total),
+		Stops: &pb.ItineraryStopStats{
+			Direct:       stopStatsToProto(its.Stops.Direct),
+			OneStop:      stopStatsToProto(its.Stops.OneStop),
+			TwoPlusStops: stopStatsToProto(its.Stops.TwoPlusStops),
+		},
+		HasChangeAirportTransfer: its.HasChangeAirportTransfer,
+	}}
+}
+
+func statsFromProto(s *pb.Stats) skyscanner.Stats {
+	its := s.GetItineraries()
+	stops := its.GetStops()
+	return skyscanner.Stats{Itineraries: skyscanner.ItineraryStats{
+		MinDuration: its.GetMinDuration(),
+		MaxDuration: its.GetMaxDuration(),
+		Total:       summaryFromProto(its.GetTotal()),
+		Stops: skyscanner.ItineraryStopStats{
+			Direct:       stopStatsFromProto(stops.GetDirect()),
+			OneStop:      stopStatsFromProto(stops.GetOneStop()),
+			TwoPlusStops: stopStatsFromProto(stops.GetTwoPlusStops()),
+		},
+		HasChangeAirportTransfer: its.GetHasChangeAirportTransfer(),
+	}}
+}
+
+func stopStatsToProto(s skyscanner.ItineraryStopSummaryStats) *pb.ItineraryStopSummaryStats {
+	return &pb.ItineraryStopSummaryStats{
+		Total: summaryToProto(s.Total),
+		TicketTypes: &pb.ItineraryStopTicketStats{
+			SingleTicket:      summaryToProto(s.TicketTypes.SingleTicket),
+			MultiTicketNonNpt: summaryToProto(s.TicketTypes.MultiTicketNonNpt),
+			MultiTicketNpt:    summaryToProto(s.TicketTypes.MultiTicketNpt),
+		},
+	}
+}
+
+func stopStatsFromProto(s *pb.ItineraryStopSummaryStats) skyscanner.ItineraryStopSummaryStats {
+	tt := s.GetTicketTypes()
+	return skyscanner.ItineraryStopSummaryStats{
+		Total: summaryFromProto(s.GetTotal()),
+		TicketTypes: skyscanner.ItineraryStopTicketStats{
+			SingleTicket:      summaryFromProto(tt.GetSingleTicket()),
+			MultiTicketNonNpt: summaryFromProto(tt.GetMultiTicketNonNpt()),
+			MultiTicketNpt:    summaryFromProto(tt.GetMultiTicketNpt()),
+		},
+	}
+}
+
+func summaryToProto(s skyscanner.ItinerarySummary) *pb.ItinerarySummary {
+	return &pb.ItinerarySummary{Count: s.Count, MinPrice: priceToProto(s.MinPrice)}
+}
+
+func summaryFromProto(s *pb.ItinerarySummary) skyscanner.ItinerarySummary {
+	return skyscanner.ItinerarySummary{Count: s.GetCount(), MinPrice: priceFromProto(s.GetMinPrice())}
+}
+
+func sortingOptionsToProto(s skyscanner.SortingOptions) *pb.SortingOptions {
+	convert := func(item skyscanner.SortingOptionItem) *pb.SortingOptionItem {
+		return &pb.SortingOptionItem{Score: item.Score, ItineraryId: item.ItineraryID}
+	}
+
+	return &pb.SortingOptions{
+		Best:     convertSlice(s.Best, convert),
+		Cheapest: convertSlice(s.Cheapest, convert),
+		Fastest:  convertSlice(s.Fastest, convert),
+	}
+}
+
+func sortingOptionsFromProto(s *pb.SortingOptions) skyscanner.SortingOptions {
+	convert := func(item *pb.SortingOptionItem) skyscanner.SortingOptionItem {
+		return skyscanner.SortingOptionItem{Score: item.GetScore(), ItineraryID: item.GetItineraryId()}
+	}
+
+	return skyscanner.SortingOptions{
+		Best:     convertSlice(s.GetBest(), convert),
+		Cheapest: convertSlice(s.GetCheapest(), convert),
+		Fastest:  convertSlice(s.GetFastest(), convert),
+	}
+}
+
+func localeToProto(l skyscanner.Locale) *pb.Locale {
+	return &pb.Locale{Code: l.Code, Name: l.Name}
+}
+
+func localeFromProto(l *pb.Locale) skyscanner.Locale {
+	return skyscanner.Locale{Code: l.GetCode(), Name: l.GetName()}
+}
+
+func marketToProto(m skyscanner.Market) *pb.Market {
+	return &pb.Market{Code: m.Code, Name: m.Name}
+}
+
+func marketFromProto(m *pb.Market) skyscanner.Market {
+	return skyscanner.Market{Code: m.GetCode(), Name: m.GetName()}
+}
+
+func currencyToProto(c skyscanner.Currency) *pb.Currency {
+	return &pb.Currency{
+		Code:                        c.Code,
+		Symbol:                      c.Symbol,
+		ThousandsSeparator:          c.ThousandsSeparator,
+		DecimalSeparator:            c.DecimalSeparator,
+		SymbolOnLeft:                c.SymbolOnLeft,
+		SpaceBetweenAmountAndSymbol: c.SpaceBetweenAmountAndSymbol,
+		DecimalDigits:               c.DecimalDigits,
+	}
+}
+
+func currencyFromProto(c *pb.Currency) skyscanner.Currency {
+	return skyscanner.Currency{
+		Code:                        c.GetCode(),
+		Symbol:                      c.GetSymbol(),
+		ThousandsSeparator:          c.GetThousandsSeparator(),
+		DecimalSeparator:            c.GetDecimalSeparator(),
+		SymbolOnLeft:                c.GetSymbolOnLeft(),
+		SpaceBetweenAmountAndSymbol: c.GetSpaceBetweenAmountAndSymbol(),
+		DecimalDigits:               c.GetDecimalDigits(),
+	}
+}
+
+func autoSuggestPlaceToProto(p skyscanner.AutoSuggestPlace) *pb.AutoSuggestPlace {
+	a := p.AirportInformation
+	return &pb.AutoSuggestPlace{
+		EntityId:    p.EntityId,
+		IataCode:    p.IATACode,
+		ParentId:    p.ParentID,
+		Name:        p.Name,
+		CountryId:   p.CountryID,
+		CountryName: p.CountryName,
+		CityName:    p.CityName,
+		Location:    p.Location,
+		Hierarchy:   p.Hierarchy,
+		Type:        enumToProto[pb.PlaceType](string(p.Type), pb.PlaceType_value),
+		Highlighting: convertSlice(p.Highlighting, func(r []int32) *pb.Highlight {
+			return &pb.Highlight{Range: r}
+		}),
+		AirportInformation: &pb.AirportInformation{
+			IataCode:  a.IATACode,
+			Name:      a.Name,
+			CountryId: a.CountryID,
+			CityId:    a.CityID,
+			EntityId:  a.EntityId,
+			ParentId:  a.ParentID,
+			Distance:  &pb.Distance{Value: a.Distance.Value, UnitCode: a.Distance.UnitCode},
+			Location:  a.Location,
+		},
+	}
+}
+
+func autoSuggestPlaceFromProto(p *pb.AutoSuggestPlace) skyscanner.AutoSuggestPlace {
+	a := p.GetAirportInformation()
+	return skyscanner.AutoSuggestPlace{
+		EntityId:    p.GetEntityId(),
+		IATACode:    p.GetIataCode(),
+		ParentID:    p.GetParentId(),
+		Name:        p.GetName(),
+		CountryID:   p.GetCountryId(),
+		CountryName: p.GetCountryName(),
+		CityName:    p.GetCityName(),
+		Location:    p.GetLocation(),
+		Hierarchy:   p.GetHierarchy(),
+		Type:        enumFromProto[skyscanner.PlaceType](p.GetType()),
+		Highlighting: convertSlice(p.GetHighlighting(), func(h *pb.Highlight) []int32 {
+			return h.GetRange()
+		}),
+		AirportInformation: skyscanner.AirportInformation{
+			IATACode:  a.GetIataCode(),
+			Name:      a.GetName(),
+			CountryID: a.GetCountryId(),
+			CityID:    a.GetCityId(),
+			EntityId:  a.GetEntityId(),
+			ParentID:  a.GetParentId(),
+			Distance:  skyscanner.Distance{Value: a.GetDistance().GetValue(), UnitCode: a.GetDistance().GetUnitCode()},
+			Location:  a.GetLocation(),
+		},
+	}
+}
@@ -0,0 +1,308 @@
+package grpcserver
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/VitaliyJ/skyscanner"
+	pb "github.com/VitaliyJ/skyscanner/proto/skyscanner/v1"
+)
+
+// wire marshals and unmarshals the message, so round trips go through the encoding
+func wire[M proto.Message](t *testing.T, m M) M {
+	t.Helper()
+
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	out := m.ProtoReflect().New().Interface().(M)
+	if err := proto.Unmarshal(b, out); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	return out
+}
+
+func checkRoundTrip(t *testing.T, want, got interface{}) {
+	t.Helper()
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch\nwant %+v\ngot  %+v", want, got)
+	}
+}
+
+func testDatetime(day int32) skyscanner.LocalDatetime {
+	return skyscanner.LocalDatetime{Year: 2024, Month: 11, Day: day, Hour: 9, Minute: 30, Second: 15}
+}
+
+func testPrice(amount string) skyscanner.Price {
+	return skyscanner.Price{Amount: amount, Unit: skyscanner.PriceUnitMilli}
+}
+
+func testSummary(count int32, amount string) skyscanner.ItinerarySummary {
+	return skyscanner.ItinerarySummary{Count: count, MinPrice: testPrice(amount)}
+}
+
+func testStopStats(count int32) skyscanner.ItineraryStopSummaryStats {
+	return skyscanner.ItineraryStopSummaryStats{
+		Total: testSummary(count, "100000"),
+		TicketTypes: skyscanner.ItineraryStopTicketStats{
+			SingleTicket:      testSummary(count, "100000"),
+			MultiTicketNonNpt: testSummary(1, "120000"),
+			MultiTicketNpt:    testSummary(1, "90000"),
+		},
+	}
+}
+
+func testLocale() skyscanner.Locale {
+	return skyscanner.Locale{Code: "en-GB", Name: "English (United Kingdom)"}
+}
+
+func testMarket() skyscanner.Market {
+	return skyscanner.Market{Code: "UK", Name: "United Kingdom"}
+}
+
+func testCurrency() skyscanner.Currency {
+	return skyscanner.Currency{
+		Code:                        "GBP",
+		Symbol:                      "£",
+		ThousandsSeparator:          ",",
+		DecimalSeparator:            ".",
+		SymbolOnLeft:                true,
+		SpaceBetweenAmountAndSymbol: true,
+		DecimalDigits:               2,
+	}
+}
+
+func TestSearchRequestRoundTrip(t *testing.T) {
+	date := testDatetime(3)
+	want := &skyscanner.CreateRequest{Query: &skyscanner.CreateRequestQuery{
+		Market:   "UK",
+		Locale:   "en-GB",
+		Currency: "GBP",
+		QueryLegs: []*skyscanner.QueryLeg{{
+			OriginPlaceId:      &skyscanner.PlaceID{IATA: "LHR"},
+			DestinationPlaceId: &skyscanner.PlaceID{EntityId: "27537542"},
+			Date:               &date,
+		}},
+		Adults:                    2,
+		IncludedCarriersIds:       []string{"-32732"},
+		CabinClass:                skyscanner.CabinClassBusiness,
+		ChildrenAges:              []int{4, 11},
+		ExcludedCarriersIds:       []string{"-32480"},
+		IncludedAgentsIds:         []string{"ba"},
+		ExcludedAgentsIds:         []string{"mytr"},
+		IncludeSustainabilityData: true,
+		NearbyAirports:            true,
+	}}
+
+	checkRoundTrip(t, want, SearchRequestFromProto(wire(t, SearchRequestToProto(want))))
+}
+
+func TestSearchResponseRoundTrip(t *testing.T) {
+	want := &skyscanner.CreatePollResponse{
+		Status: skyscanner.ResponseStatusIncomplete,
+		Action: skyscanner.ResponseActionReplaced,
+		Content: &skyscanner.Content{
+			Results: &skyscanner.Results{
+				Itineraries: map[string]skyscanner.ItineraryResult{
+					"it1": {
+						PricingOptions: []skyscanner.PricingOption{{
+							Price:    testPrice("100000"),
+							AgentIds: []string{"ba"},
+							Items: []skyscanner.LivePricingOptionItem{{
+								Price:    testPrice("100000"),
+								AgentID:  "ba",
+								DeepLink: "https://example.com/book",
+								Fares: []skyscanner.LivePricingOptionItemFares{
+									{SegmentID: "seg1", BookingCode: "Y", FareBasisCode: "YOW"},
+								},
+							}},
+							TransferType: skyscanner.TransferTypeManaged,
+						}},
+						LegIds:             []string{"leg1"},
+						SustainabilityData: skyscanner.SustainabilityData{IsEcoContender: true, EcoContenderDelta: 12.5},
+					},
+				},
+				Legs: map[string]skyscanner.FlightLeg{
+					"leg1": {
+						OriginPlaceID:       "95565050",
+						DestinationPlaceID:  "95565058",
+						DepartureDateTime:   testDatetime(3),
+						ArrivalDateTime:     testDatetime(4),
+						DurationInMinutes:   480,
+						StopCount:           0,
+						MarketingCarrierIds: []string{"-32732"},
+						OperatingCarrierIds: []string{"-32732"},
+						SegmentIds:          []string{"seg1"},
+					},
+				},
+				Segments: map[string]skyscanner.Segment{
+					"seg1": {
+						OriginPlaceID:         "95565050",
+						DestinationPlaceID:    "95565058",
+						DepartureDateTime:     testDatetime(3),
+						ArrivalDateTime:       testDatetime(4),
+						DurationInMinutes:     480,
+						MarketingFlightNumber: "117",
+						MarketingCarrierId:    "-32732",
+						OperatingCarrierId:    "-32732",
+					},
+				},
+				Places: map[string]skyscanner.Place{
+					"95565050": {EntityId: "95565050", ParentId: "27544008", Name: "London Heathrow", Type: skyscanner.PlaceTypeAirport, IATA: "LHR"},
+				},
+				Carriers: map[string]skyscanner.Carrier{
+					"-32732": {Name: "British Airways", AllianceID: "1", ImageURL: "https://example.com/ba.png", IATA: "BA"},
+				},
+				Agents: map[string]skyscanner.Agent{
+					"ba": {
+						Name:          "British Airways",
+						Type:          skyscanner.AgentTypeAirline,
+						ImageURL:      "https://example.com/ba.png",
+						FeedbackCount: 120,
+						Rating:        4.5,
+						RatingBreakdown: skyscanner.AgentRatingBreakdown{
+							CustomerService: 4.1,
+							ReliablePrices:  4.2,
+							ClearExtraFees:  4.3,
+							EaseOfBooking:   4.4,
+							Other:           4.6,
+						},
+						IsOptimisedForMobile: true,
+					},
+				},
+				Alliances: map[string]skyscanner.Alliance{"1": {Name: "oneworld"}},
+			},
+			Stats: &skyscanner.Stats{Itineraries: skyscanner.ItineraryStats{
+				MinDuration: 480,
+				MaxDuration: 720,
+				Total:       testSummary(3, "90000"),
+				Stops: skyscanner.ItineraryStopStats{
+					Direct:       testStopStats(1),
+					OneStop:      testStopStats(1),
+					TwoPlusStops: testStopStats(1),
+				},
+				HasChangeAirportTransfer: true,
+			}},
+			SortingOptions: &skyscanner.SortingOptions{
+				Best:     []skyscanner.SortingOptionItem{{Score: 0.9, ItineraryID: "it1"}},
+				Cheapest: []skyscanner.SortingOptionItem{{Score: 0.8, ItineraryID: "it1"}},
+				Fastest:  []skyscanner.SortingOptionItem{{Score: 0.7, ItineraryID: "it1"}},
+			},
+		},
+	}
+
+	got := SearchResponseFromProto(wire(t, SearchResponseToProto(want)))
+	checkRoundTrip(t, want, got)
+
+	withToken := *want
+	withToken.SessionToken = "secret"
+	if p := SearchResponseToProto(&withToken); p.String() != SearchResponseToProto(want).String() {
+		t.Error("session token is exposed")
+	}
+}
+
+func TestAutoSuggestRequestRoundTrip(t *testing.T) {
+	want := &skyscanner.AutoSuggestFlightsRequest{
+		Query: skyscanner.AutoSuggestFlightsRequestQuery{
+			Locale:              "en-GB",
+			Market:              "UK",
+			SearchTerm:          "Lond",
+			IncludedEntityTypes: []skyscanner.PlaceType{skyscanner.PlaceTypeCity, skyscanner.PlaceTypeAirport},
+		},
+		Limit:         5,
+		IsDestination: true,
+	}
+
+	checkRoundTrip(t, want, AutoSuggestRequestFromProto(wire(t, AutoSuggestRequestToProto(want))))
+}
+
+func TestAutoSuggestResponseRoundTrip(t *testing.T) {
+	want := &skyscanner.AutoSuggestFlightsResponse{Places: []*skyscanner.AutoSuggestPlace{{
+		EntityId:     "95565050",
+		IATACode:     "LHR",
+		ParentID:     "27544008",
+		Name:         "London Heathrow",
+		CountryID:    "29475437",
+		CountryName:  "United Kingdom",
+		CityName:     "London",
+		Location:     "51.4775, -0.461389",
+		Hierarchy:    "London|United Kingdom",
+		Type:         skyscanner.PlaceTypeAirport,
+		Highlighting: [][]int32{{0, 4}},
+		AirportInformation: skyscanner.AirportInformation{
+			IATACode:  "LHR",
+			Name:      "London Heathrow",
+			CountryID: "29475437",
+			CityID:    "27544008",
+			EntityId:  "95565050",
+			ParentID:  "27544008",
+			Distance:  skyscanner.Distance{Value: 24.5, UnitCode: "km"},
+			Location:  "51.4775, -0.461389",
+		},
+	}}}
+
+	checkRoundTrip(t, want, AutoSuggestResponseFromProto(wire(t, AutoSuggestResponseToProto(want))))
+}
+
+func TestLocalesResponseRoundTrip(t *testing.T) {
+	want := &skyscanner.LocalesResponse{
+		Status:  skyscanner.ResponseStatusComplete,
+		Locales: []skyscanner.Locale{testLocale()},
+	}
+
+	checkRoundTrip(t, want, LocalesResponseFromProto(wire(t, LocalesResponseToProto(want))))
+}
+
+func TestCurrenciesResponseRoundTrip(t *testing.T) {
+	want := &skyscanner.CurrenciesResponse{
+		Status:     skyscanner.ResponseStatusComplete,
+		Currencies: []skyscanner.Currency{testCurrency()},
+	}
+
+	checkRoundTrip(t, want, CurrenciesResponseFromProto(wire(t, CurrenciesResponseToProto(want))))
+}
+
+func TestMarketsResponseRoundTrip(t *testing.T) {
+	want := &skyscanner.MarketsResponse{
+		Status:  skyscanner.ResponseStatusComplete,
+		Markets: []skyscanner.Market{testMarket()},
+	}
+
+	checkRoundTrip(t, want, MarketsResponseFromProto(wire(t, MarketsResponseToProto(want))))
+}
+
+func TestNearestCultureResponseRoundTrip(t *testing.T) {
+	want := &skyscanner.NearestCultureResponse{
+		Status:   skyscanner.ResponseStatusComplete,
+		Market:   testMarket(),
+		Locale:   testLocale(),
+		Currency: testCurrency(),
+	}
+
+	checkRoundTrip(t, want, NearestCultureResponseFromProto(wire(t, NearestCultureResponseToProto(want))))
+}
+
+func TestEnumsMatchSDKConstants(t *testing.T) {
+	for _, c := range []skyscanner.CabinClass{
+		skyscanner.CabinClassEconomy,
+		skyscanner.CabinClassPremiumEconomy,
+		skyscanner.CabinClassBusiness,
+		skyscanner.CabinClassFirst,
+	} {
+		if got := enumFromProto[skyscanner.CabinClass](enumToProto[pb.CabinClass](string(c), pb.CabinClass_value)); got != c {
+			t.Errorf("cabin class %s converted to %s", c, got)
+		}
+	}
+
+	if got := enumToProto[pb.CabinClass]("", pb.CabinClass_value); got != pb.CabinClass_CABIN_CLASS_UNSPECIFIED {
+		t.Errorf("empty cabin class converted to %s", got)
+	}
+	if got := enumFromProto[skyscanner.CabinClass](pb.CabinClass_CABIN_CLASS_UNSPECIFIED); got != "" {
+		t.Errorf("unspecified cabin class converted to %q", got)
+	}
+}
@@ -0,0 +1,139 @@
+// Package grpcserver implements the skyscanner.v1.FlightSearch gRPC service over the SDK Client
+// and converts between the protobuf messages and the SDK types.
+//
+// It is a separate module, so the SDK does not depend on gRPC
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/VitaliyJ/skyscanner"
+	pb "github.com/VitaliyJ/skyscanner/proto/skyscanner/v1"
+)
+
+// Server implements the FlightSearch service
+type Server struct {
+	pb.UnimplementedFlightSearchServer
+
+	client skyscanner.Client
+}
+
+// NewServer returns the FlightSearch service calling the client
+func NewServer(c skyscanner.Client) *Server {
+	return &Server{client: c}
+}
+
+// Search streams the accumulated response after the create request and every poll until the search is complete
+func (s *Server) Search(req *pb.SearchRequest, stream pb.FlightSearch_SearchServer) error {
+	if req.GetQuery() == nil {
+		return status.Error(codes.InvalidArgument, "query is required")
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	var sendErr error
+	_, errResp := skyscanner.SearchWithProgress(ctx, s.client, SearchRequestFromProto(req), func(resp *skyscanner.CreatePollResponse) {
+		if sendErr != nil {
+			return
+		}
+		if sendErr = stream.Send(SearchResponseToProto(resp)); sendErr != nil {
+			cancel()
+		}
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	if errResp != nil {
+		return statusError(errResp)
+	}
+
+	return nil
+}
+
+// AutoSuggestFlights returns places matching the search term
+func (s *Server) AutoSuggestFlights(ctx context.Context, req *pb.AutoSuggestFlightsRequest) (*pb.AutoSuggestFlightsResponse, error) {
+	resp, errResp := s.client.AutoSuggestFlights(ctx, AutoSuggestRequestFromProto(req))
+	if errResp != nil {
+		return nil, statusError(errResp)
+	}
+
+	return AutoSuggestResponseToProto(resp), nil
+}
+
+// Locales returns the supported locales
+func (s *Server) Locales(ctx context.Context, _ *pb.LocalesRequest) (*pb.LocalesResponse, error) {
+	resp, errResp := s.client.Locales(ctx)
+	if errResp != nil {
+		return nil, statusError(errResp)
+	}
+
+	return LocalesResponseToProto(resp), nil
+}
+
+// Currencies returns the supported currencies
+func (s *Server) Currencies(ctx context.Context, _ *pb.CurrenciesRequest) (*pb.CurrenciesResponse, error) {
+	resp, errResp := s.client.Currencies(ctx)
+	if errResp != nil {
+		return nil, statusError(errResp)
+	}
+
+	return CurrenciesResponseToProto(resp), nil
+}
+
+// Markets returns the supported markets for the locale
+func (s *Server) Markets(ctx context.Context, req *pb.MarketsRequest) (*pb.MarketsResponse, error) {
+	resp, errResp := s.client.Markets(ctx, req.GetLocale())
+	if errResp != nil {
+		return nil, statusError(errResp)
+	}
+
+	return MarketsResponseToProto(resp), nil
+}
+
+// NearestCulture returns the market, locale and currency for the IP address
+func (s *Server) NearestCulture(ctx context.Context, req *pb.NearestCultureRequest) (*pb.NearestCultureResponse, error) {
+	resp, errResp := s.client.NearestCulture(ctx, req.GetIpAddress())
+	if errResp != nil {
+		return nil, statusError(errResp)
+	}
+
+	return NearestCultureResponseToProto(resp), nil
+}
+
+// statusError converts the SDK error to a gRPC status by the HTTP status code
+func statusError(errResp *skyscanner.ErrorResponse) error {
+	code := codes.Internal
+	switch {
+	case errors.Is(errResp, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(errResp, context.DeadlineExceeded), errors.Is(errResp, skyscanner.ErrTimeout):
+		code = codes.DeadlineExceeded
+	case errors.Is(errResp, skyscanner.ErrCircuitOpen):
+		code = codes.Unavailable
+	default:
+		switch errResp.Code {
+		case http.StatusBadRequest:
+			code = codes.InvalidArgument
+		case http.StatusUnauthorized:
+			code = codes.Unauthenticated
+		case http.StatusForbidden:
+			code = codes.PermissionDenied
+		case http.StatusNotFound, http.StatusGone:
+			code = codes.NotFound
+		case http.StatusTooManyRequests:
+			code = codes.ResourceExhausted
+		case http.StatusServiceUnavailable:
+			code = codes.Unavailable
+		case http.StatusGatewayTimeout:
+			code = codes.DeadlineExceeded
+		}
+	}
+
+	return status.Error(code, errResp.Message)
+}
@@ -0,0 +1,129 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/VitaliyJ/skyscanner"
+	pb "github.com/VitaliyJ/skyscanner/proto/skyscanner/v1"
+)
+
+// fakeClient completes a search after one poll and fails the culture requests
+type fakeClient struct {
+	skyscanner.Client
+	polls int
+}
+
+func (c *fakeClient) Create(_ context.Context, req *skyscanner.CreateRequest) (*skyscanner.CreatePollResponse, *skyscanner.ErrorResponse) {
+	if req.Query.Market != "UK" {
+		return nil, &skyscanner.ErrorResponse{Code: http.StatusBadRequest, Message: "invalid market"}
+	}
+
+	return &skyscanner.CreatePollResponse{
+		SessionToken: "token",
+		Status:       skyscanner.ResponseStatusIncomplete,
+		Action:       skyscanner.ResponseActionReplaced,
+		Content:      &skyscanner.Content{Results: &skyscanner.Results{Itineraries: map[string]skyscanner.ItineraryResult{"it1": {LegIds: []string{"leg1"}}}}},
+	}, nil
+}
+
+func (c *fakeClient) Poll(_ context.Context, _ *skyscanner.PollRequest) (*skyscanner.CreatePollResponse, *skyscanner.ErrorResponse) {
+	c.polls++
+
+	return &skyscanner.CreatePollResponse{
+		Status:  skyscanner.ResponseStatusComplete,
+		Action:  skyscanner.ResponseActionReplaced,
+		Content: &skyscanner.Content{Results: &skyscanner.Results{Itineraries: map[string]skyscanner.ItineraryResult{"it1": {LegIds: []string{"leg1"}}, "it2": {LegIds: []string{"leg2"}}}}},
+	}, nil
+}
+
+func (c *fakeClient) Locales(_ context.Context) (*skyscanner.LocalesResponse, *skyscanner.ErrorResponse) {
+	return nil, &skyscanner.ErrorResponse{Code: http.StatusTooManyRequests, Message: "rate limited"}
+}
+
+func newTestClient(t *testing.T, c skyscanner.Client) pb.FlightSearchClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	pb.RegisterFlightSearchServer(srv, NewServer(c))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pb.NewFlightSearchClient(conn)
+}
+
+func TestServerSearchStreamsProgress(t *testing.T) {
+	fc := &fakeClient{}
+	client := newTestClient(t, fc)
+
+	stream, err := client.Search(context.Background(), &pb.SearchRequest{Query: &pb.CreateRequestQuery{Market: "UK"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []*pb.SearchResponse
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, resp)
+	}
+
+	if len(got) != 2 || fc.polls != 1 {
+		t.Fatalf("got %d messages after %d polls, want 2 after 1", len(got), fc.polls)
+	}
+	if got[0].Status != pb.ResultStatus_RESULT_STATUS_INCOMPLETE || len(got[0].Content.Results.Itineraries) != 1 {
+		t.Errorf("unexpected first message %v", got[0])
+	}
+	if got[1].Status != pb.ResultStatus_RESULT_STATUS_COMPLETE || len(got[1].Content.Results.Itineraries) != 2 {
+		t.Errorf("unexpected last message %v", got[1])
+	}
+}
+
+func TestServerErrors(t *testing.T) {
+	client := newTestClient(t, &fakeClient{})
+
+	stream, err := client.Search(context.Background(), &pb.SearchRequest{Query: &pb.CreateRequestQuery{Market: "US"}})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("search error code is %v, want InvalidArgument", status.Code(err))
+	}
+
+	stream, err = client.Search(context.Background(), &pb.SearchRequest{})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("empty search error code is %v, want InvalidArgument", status.Code(err))
+	}
+
+	if _, err := client.Locales(context.Background(), &pb.LocalesRequest{}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("locales error code is %v, want ResourceExhausted", status.Code(err))
+	}
+}
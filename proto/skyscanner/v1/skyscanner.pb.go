@@ -0,0 +1,3901 @@
+// Protobuf definitions mirroring the SDK request and response types.
+// Enum value names match the SDK string constants, so they convert by name.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: skyscanner/v1/skyscanner.proto
+
+package skyscannerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CabinClass int32
+
+const (
+	CabinClass_CABIN_CLASS_UNSPECIFIED     CabinClass = 0
+	CabinClass_CABIN_CLASS_ECONOMY         CabinClass = 1
+	CabinClass_CABIN_CLASS_PREMIUM_ECONOMY CabinClass = 2
+	CabinClass_CABIN_CLASS_BUSINESS        CabinClass = 3
+	CabinClass_CABIN_CLASS_FIRST           CabinClass = 4
+)
+
+// Enum value maps for CabinClass.
+var (
+	CabinClass_name = map[int32]string{
+		0: "CABIN_CLASS_UNSPECIFIED",
+		1: "CABIN_CLASS_ECONOMY",
+		2: "CABIN_CLASS_PREMIUM_ECONOMY",
+		3: "CABIN_CLASS_BUSINESS",
+		4: "CABIN_CLASS_FIRST",
+	}
+	CabinClass_value = map[string]int32{
+		"CABIN_CLASS_UNSPECIFIED":     0,
+		"CABIN_CLASS_ECONOMY":         1,
+		"CABIN_CLASS_PREMIUM_ECONOMY": 2,
+		"CABIN_CLASS_BUSINESS":        3,
+		"CABIN_CLASS_FIRST":           4,
+	}
+)
+
+func (x CabinClass) Enum() *CabinClass {
+	p := new(CabinClass)
+	*p = x
+	return p
+}
+
+func (x CabinClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CabinClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_skyscanner_v1_skyscanner_proto_enumTypes[0].Descriptor()
+}
+
+func (CabinClass) Type() protoreflect.EnumType {
+	return &file_skyscanner_v1_skyscanner_proto_enumTypes[0]
+}
+
+func (x CabinClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CabinClass.Descriptor instead.
+func (CabinClass) EnumDescriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{0}
+}
+
+type ResultStatus int32
+
+const (
+	ResultStatus_RESULT_STATUS_UNSPECIFIED ResultStatus = 0
+	ResultStatus_RESULT_STATUS_COMPLETE    ResultStatus = 1
+	ResultStatus_RESULT_STATUS_INCOMPLETE  ResultStatus = 2
+	ResultStatus_RESULT_STATUS_FAILED      ResultStatus = 3
+)
+
+// Enum value maps for ResultStatus.
+var (
+	ResultStatus_name = map[int32]string{
+		0: "RESULT_STATUS_UNSPECIFIED",
+		1: "RESULT_STATUS_COMPLETE",
+		2: "RESULT_STATUS_INCOMPLETE",
+		3: "RESULT_STATUS_FAILED",
+	}
+	ResultStatus_value = map[string]int32{
+		"RESULT_STATUS_UNSPECIFIED": 0,
+		"RESULT_STATUS_COMPLETE":    1,
+		"RESULT_STATUS_INCOMPLETE":  2,
+		"RESULT_STATUS_FAILED":      3,
+	}
+)
+
+func (x ResultStatus) Enum() *ResultStatus {
+	p := new(ResultStatus)
+	*p = x
+	return p
+}
+
+func (x ResultStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResultStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_skyscanner_v1_skyscanner_proto_enumTypes[1].Descriptor()
+}
+
+func (ResultStatus) Type() protoreflect.EnumType {
+	return &file_skyscanner_v1_skyscanner_proto_enumTypes[1]
+}
+
+func (x ResultStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResultStatus.Descriptor instead.
+func (ResultStatus) EnumDescriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{1}
+}
+
+type ResultAction int32
+
+const (
+	ResultAction_RESULT_ACTION_UNSPECIFIED  ResultAction = 0
+	ResultAction_RESULT_ACTION_REPLACED     ResultAction = 1
+	ResultAction_RESULT_ACTION_NOT_MODIFIED ResultAction = 2
+	ResultAction_RESULT_ACTION_OMITTED      ResultAction = 3
+)
+
+// Enum value maps for ResultAction.
+var (
+	ResultAction_name = map[int32]string{
+		0: "RESULT_ACTION_UNSPECIFIED",
+		1: "RESULT_ACTION_REPLACED",
+		2: "RESULT_ACTION_NOT_MODIFIED",
+		3: "RESULT_ACTION_OMITTED",
+	}
+	ResultAction_value = map[string]int32{
+		"RESULT_ACTION_UNSPECIFIED":  0,
+		"RESULT_ACTION_REPLACED":     1,
+		"RESULT_ACTION_NOT_MODIFIED": 2,
+		"RESULT_ACTION_OMITTED":      3,
+	}
+)
+
+func (x ResultAction) Enum() *ResultAction {
+	p := new(ResultAction)
+	*p = x
+	return p
+}
+
+func (x ResultAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResultAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_skyscanner_v1_skyscanner_proto_enumTypes[2].Descriptor()
+}
+
+func (ResultAction) Type() protoreflect.EnumType {
+	return &file_skyscanner_v1_skyscanner_proto_enumTypes[2]
+}
+
+func (x ResultAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResultAction.Descriptor instead.
+func (ResultAction) EnumDescriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{2}
+}
+
+type PriceUnit int32
+
+const (
+	PriceUnit_PRICE_UNIT_UNSPECIFIED PriceUnit = 0
+	PriceUnit_PRICE_UNIT_WHOLE       PriceUnit = 1
+	PriceUnit_PRICE_UNIT_CENTI       PriceUnit = 2
+	PriceUnit_PRICE_UNIT_MILLI       PriceUnit = 3
+	PriceUnit_PRICE_UNIT_MICRO       PriceUnit = 4
+)
+
+// Enum value maps for PriceUnit.
+var (
+	PriceUnit_name = map[int32]string{
+		0: "PRICE_UNIT_UNSPECIFIED",
+		1: "PRICE_UNIT_WHOLE",
+		2: "PRICE_UNIT_CENTI",
+		3: "PRICE_UNIT_MILLI",
+		4: "PRICE_UNIT_MICRO",
+	}
+	PriceUnit_value = map[string]int32{
+		"PRICE_UNIT_UNSPECIFIED": 0,
+		"PRICE_UNIT_WHOLE":       1,
+		"PRICE_UNIT_CENTI":       2,
+		"PRICE_UNIT_MILLI":       3,
+		"PRICE_UNIT_MICRO":       4,
+	}
+)
+
+func (x PriceUnit) Enum() *PriceUnit {
+	p := new(PriceUnit)
+	*p = x
+	return p
+}
+
+func (x PriceUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_skyscanner_v1_skyscanner_proto_enumTypes[3].Descriptor()
+}
+
+func (PriceUnit) Type() protoreflect.EnumType {
+	return &file_skyscanner_v1_skyscanner_proto_enumTypes[3]
+}
+
+func (x PriceUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceUnit.Descriptor instead.
+func (PriceUnit) EnumDescriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{3}
+}
+
+type TransferType int32
+
+const (
+	TransferType_TRANSFER_TYPE_UNSPECIFIED             TransferType = 0
+	TransferType_TRANSFER_TYPE_MANAGED                 TransferType = 1
+	TransferType_TRANSFER_TYPE_SELF_TRANSFER           TransferType = 2
+	TransferType_TRANSFER_TYPE_PROTECTED_SELF_TRANSFER TransferType = 3
+)
+
+// Enum value maps for TransferType.
+var (
+	TransferType_name = map[int32]string{
+		0: "TRANSFER_TYPE_UNSPECIFIED",
+		1: "TRANSFER_TYPE_MANAGED",
+		2: "TRANSFER_TYPE_SELF_TRANSFER",
+		3: "TRANSFER_TYPE_PROTECTED_SELF_TRANSFER",
+	}
+	TransferType_value = map[string]int32{
+		"TRANSFER_TYPE_UNSPECIFIED":             0,
+		"TRANSFER_TYPE_MANAGED":                 1,
+		"TRANSFER_TYPE_SELF_TRANSFER":           2,
+		"TRANSFER_TYPE_PROTECTED_SELF_TRANSFER": 3,
+	}
+)
+
+func (x TransferType) Enum() *TransferType {
+	p := new(TransferType)
+	*p = x
+	return p
+}
+
+func (x TransferType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferType) Descriptor() protoreflect.EnumDescriptor {
+	return file_skyscanner_v1_skyscanner_proto_enumTypes[4].Descriptor()
+}
+
+func (TransferType) Type() protoreflect.EnumType {
+	return &file_skyscanner_v1_skyscanner_proto_enumTypes[4]
+}
+
+func (x TransferType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferType.Descriptor instead.
+func (TransferType) EnumDescriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{4}
+}
+
+type PlaceType int32
+
+const (
+	PlaceType_PLACE_TYPE_UNSPECIFIED PlaceType = 0
+	PlaceType_PLACE_TYPE_AIRPORT     PlaceType = 1
+	PlaceType_PLACE_TYPE_CITY        PlaceType = 2
+	PlaceType_PLACE_TYPE_COUNTRY     PlaceType = 3
+	PlaceType_PLACE_TYPE_CONTINENT   PlaceType = 4
+)
+
+// Enum value maps for PlaceType.
+var (
+	PlaceType_name = map[int32]string{
+		0: "PLACE_TYPE_UNSPECIFIED",
+		1: "PLACE_TYPE_AIRPORT",
+		2: "PLACE_TYPE_CITY",
+		3: "PLACE_TYPE_COUNTRY",
+		4: "PLACE_TYPE_CONTINENT",
+	}
+	PlaceType_value = map[string]int32{
+		"PLACE_TYPE_UNSPECIFIED": 0,
+		"PLACE_TYPE_AIRPORT":     1,
+		"PLACE_TYPE_CITY":        2,
+		"PLACE_TYPE_COUNTRY":     3,
+		"PLACE_TYPE_CONTINENT":   4,
+	}
+)
+
+func (x PlaceType) Enum() *PlaceType {
+	p := new(PlaceType)
+	*p = x
+	return p
+}
+
+func (x PlaceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlaceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_skyscanner_v1_skyscanner_proto_enumTypes[5].Descriptor()
+}
+
+func (PlaceType) Type() protoreflect.EnumType {
+	return &file_skyscanner_v1_skyscanner_proto_enumTypes[5]
+}
+
+func (x PlaceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlaceType.Descriptor instead.
+func (PlaceType) EnumDescriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{5}
+}
+
+type AgentType int32
+
+const (
+	AgentType_AGENT_TYPE_UNSPECIFIED  AgentType = 0
+	AgentType_AGENT_TYPE_TRAVEL_AGENT AgentType = 1
+	AgentType_AGENT_TYPE_AIRLINE      AgentType = 2
+)
+
+// Enum value maps for AgentType.
+var (
+	AgentType_name = map[int32]string{
+		0: "AGENT_TYPE_UNSPECIFIED",
+		1: "AGENT_TYPE_TRAVEL_AGENT",
+		2: "AGENT_TYPE_AIRLINE",
+	}
+	AgentType_value = map[string]int32{
+		"AGENT_TYPE_UNSPECIFIED":  0,
+		"AGENT_TYPE_TRAVEL_AGENT": 1,
+		"AGENT_TYPE_AIRLINE":      2,
+	}
+)
+
+func (x AgentType) Enum() *AgentType {
+	p := new(AgentType)
+	*p = x
+	return p
+}
+
+func (x AgentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AgentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_skyscanner_v1_skyscanner_proto_enumTypes[6].Descriptor()
+}
+
+func (AgentType) Type() protoreflect.EnumType {
+	return &file_skyscanner_v1_skyscanner_proto_enumTypes[6]
+}
+
+func (x AgentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AgentType.Descriptor instead.
+func (AgentType) EnumDescriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{6}
+}
+
+type Price struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        string                 `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Unit          PriceUnit              `protobuf:"varint,2,opt,name=unit,proto3,enum=skyscanner.v1.PriceUnit" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Price) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Price) GetUnit() PriceUnit {
+	if x != nil {
+		return x.Unit
+	}
+	return PriceUnit_PRICE_UNIT_UNSPECIFIED
+}
+
+type LocalDatetime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day           int32                  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	Hour          int32                  `protobuf:"varint,4,opt,name=hour,proto3" json:"hour,omitempty"`
+	Minute        int32                  `protobuf:"varint,5,opt,name=minute,proto3" json:"minute,omitempty"`
+	Second        int32                  `protobuf:"varint,6,opt,name=second,proto3" json:"second,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalDatetime) Reset() {
+	*x = LocalDatetime{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalDatetime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalDatetime) ProtoMessage() {}
+
+func (x *LocalDatetime) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalDatetime.ProtoReflect.Descriptor instead.
+func (*LocalDatetime) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LocalDatetime) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *LocalDatetime) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *LocalDatetime) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *LocalDatetime) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *LocalDatetime) GetMinute() int32 {
+	if x != nil {
+		return x.Minute
+	}
+	return 0
+}
+
+func (x *LocalDatetime) GetSecond() int32 {
+	if x != nil {
+		return x.Second
+	}
+	return 0
+}
+
+type PlaceId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Iata          string                 `protobuf:"bytes,1,opt,name=iata,proto3" json:"iata,omitempty"`
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceId) Reset() {
+	*x = PlaceId{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceId) ProtoMessage() {}
+
+func (x *PlaceId) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceId.ProtoReflect.Descriptor instead.
+func (*PlaceId) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlaceId) GetIata() string {
+	if x != nil {
+		return x.Iata
+	}
+	return ""
+}
+
+func (x *PlaceId) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+type QueryLeg struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OriginPlaceId      *PlaceId               `protobuf:"bytes,1,opt,name=origin_place_id,json=originPlaceId,proto3" json:"origin_place_id,omitempty"`
+	DestinationPlaceId *PlaceId               `protobuf:"bytes,2,opt,name=destination_place_id,json=destinationPlaceId,proto3" json:"destination_place_id,omitempty"`
+	Date               *LocalDatetime         `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *QueryLeg) Reset() {
+	*x = QueryLeg{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLeg) ProtoMessage() {}
+
+func (x *QueryLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryLeg.ProtoReflect.Descriptor instead.
+func (*QueryLeg) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryLeg) GetOriginPlaceId() *PlaceId {
+	if x != nil {
+		return x.OriginPlaceId
+	}
+	return nil
+}
+
+func (x *QueryLeg) GetDestinationPlaceId() *PlaceId {
+	if x != nil {
+		return x.DestinationPlaceId
+	}
+	return nil
+}
+
+func (x *QueryLeg) GetDate() *LocalDatetime {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+// CreateRequestQuery mirrors skyscanner.CreateRequestQuery
+type CreateRequestQuery struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Market                    string                 `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Locale                    string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Currency                  string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	QueryLegs                 []*QueryLeg            `protobuf:"bytes,4,rep,name=query_legs,json=queryLegs,proto3" json:"query_legs,omitempty"`
+	Adults                    int32                  `protobuf:"varint,5,opt,name=adults,proto3" json:"adults,omitempty"`
+	IncludedCarriersIds       []string               `protobuf:"bytes,6,rep,name=included_carriers_ids,json=includedCarriersIds,proto3" json:"included_carriers_ids,omitempty"`
+	CabinClass                CabinClass             `protobuf:"varint,7,opt,name=cabin_class,json=cabinClass,proto3,enum=skyscanner.v1.CabinClass" json:"cabin_class,omitempty"`
+	ChildrenAges              []int32                `protobuf:"varint,8,rep,packed,name=children_ages,json=childrenAges,proto3" json:"children_ages,omitempty"`
+	ExcludedCarriersIds       []string               `protobuf:"bytes,9,rep,name=excluded_carriers_ids,json=excludedCarriersIds,proto3" json:"excluded_carriers_ids,omitempty"`
+	IncludedAgentsIds         []string               `protobuf:"bytes,10,rep,name=included_agents_ids,json=includedAgentsIds,proto3" json:"included_agents_ids,omitempty"`
+	ExcludedAgentsIds         []string               `protobuf:"bytes,11,rep,name=excluded_agents_ids,json=excludedAgentsIds,proto3" json:"excluded_agents_ids,omitempty"`
+	IncludeSustainabilityData bool                   `protobuf:"varint,12,opt,name=include_sustainability_data,json=includeSustainabilityData,proto3" json:"include_sustainability_data,omitempty"`
+	NearbyAirports            bool                   `protobuf:"varint,13,opt,name=nearby_airports,json=nearbyAirports,proto3" json:"nearby_airports,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CreateRequestQuery) Reset() {
+	*x = CreateRequestQuery{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRequestQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRequestQuery) ProtoMessage() {}
+
+func (x *CreateRequestQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRequestQuery.ProtoReflect.Descriptor instead.
+func (*CreateRequestQuery) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateRequestQuery) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *CreateRequestQuery) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *CreateRequestQuery) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateRequestQuery) GetQueryLegs() []*QueryLeg {
+	if x != nil {
+		return x.QueryLegs
+	}
+	return nil
+}
+
+func (x *CreateRequestQuery) GetAdults() int32 {
+	if x != nil {
+		return x.Adults
+	}
+	return 0
+}
+
+func (x *CreateRequestQuery) GetIncludedCarriersIds() []string {
+	if x != nil {
+		return x.IncludedCarriersIds
+	}
+	return nil
+}
+
+func (x *CreateRequestQuery) GetCabinClass() CabinClass {
+	if x != nil {
+		return x.CabinClass
+	}
+	return CabinClass_CABIN_CLASS_UNSPECIFIED
+}
+
+func (x *CreateRequestQuery) GetChildrenAges() []int32 {
+	if x != nil {
+		return x.ChildrenAges
+	}
+	return nil
+}
+
+func (x *CreateRequestQuery) GetExcludedCarriersIds() []string {
+	if x != nil {
+		return x.ExcludedCarriersIds
+	}
+	return nil
+}
+
+func (x *CreateRequestQuery) GetIncludedAgentsIds() []string {
+	if x != nil {
+		return x.IncludedAgentsIds
+	}
+	return nil
+}
+
+func (x *CreateRequestQuery) GetExcludedAgentsIds() []string {
+	if x != nil {
+		return x.ExcludedAgentsIds
+	}
+	return nil
+}
+
+func (x *CreateRequestQuery) GetIncludeSustainabilityData() bool {
+	if x != nil {
+		return x.IncludeSustainabilityData
+	}
+	return false
+}
+
+func (x *CreateRequestQuery) GetNearbyAirports() bool {
+	if x != nil {
+		return x.NearbyAirports
+	}
+	return false
+}
+
+type SearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         *CreateRequestQuery    `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchRequest) GetQuery() *CreateRequestQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ResultStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=skyscanner.v1.ResultStatus" json:"status,omitempty"`
+	Action        ResultAction           `protobuf:"varint,2,opt,name=action,proto3,enum=skyscanner.v1.ResultAction" json:"action,omitempty"`
+	Content       *Content               `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchResponse) GetStatus() ResultStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ResultStatus_RESULT_STATUS_UNSPECIFIED
+}
+
+func (x *SearchResponse) GetAction() ResultAction {
+	if x != nil {
+		return x.Action
+	}
+	return ResultAction_RESULT_ACTION_UNSPECIFIED
+}
+
+func (x *SearchResponse) GetContent() *Content {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type Content struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Results        *Results               `protobuf:"bytes,1,opt,name=results,proto3" json:"results,omitempty"`
+	Stats          *Stats                 `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	SortingOptions *SortingOptions        `protobuf:"bytes,3,opt,name=sorting_options,json=sortingOptions,proto3" json:"sorting_options,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Content) Reset() {
+	*x = Content{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Content) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Content) ProtoMessage() {}
+
+func (x *Content) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Content.ProtoReflect.Descriptor instead.
+func (*Content) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Content) GetResults() *Results {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *Content) GetStats() *Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *Content) GetSortingOptions() *SortingOptions {
+	if x != nil {
+		return x.SortingOptions
+	}
+	return nil
+}
+
+// Results mirrors skyscanner.Results, maps are keyed by the entity IDs
+type Results struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Itineraries   map[string]*ItineraryResult `protobuf:"bytes,1,rep,name=itineraries,proto3" json:"itineraries,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Legs          map[string]*FlightLeg       `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Segments      map[string]*Segment         `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Places        map[string]*Place           `protobuf:"bytes,4,rep,name=places,proto3" json:"places,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Carriers      map[string]*Carrier         `protobuf:"bytes,5,rep,name=carriers,proto3" json:"carriers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Agents        map[string]*Agent           `protobuf:"bytes,6,rep,name=agents,proto3" json:"agents,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Alliances     map[string]*Alliance        `protobuf:"bytes,7,rep,name=alliances,proto3" json:"alliances,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Results) Reset() {
+	*x = Results{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Results) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Results) ProtoMessage() {}
+
+func (x *Results) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Results.ProtoReflect.Descriptor instead.
+func (*Results) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Results) GetItineraries() map[string]*ItineraryResult {
+	if x != nil {
+		return x.Itineraries
+	}
+	return nil
+}
+
+func (x *Results) GetLegs() map[string]*FlightLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *Results) GetSegments() map[string]*Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *Results) GetPlaces() map[string]*Place {
+	if x != nil {
+		return x.Places
+	}
+	return nil
+}
+
+func (x *Results) GetCarriers() map[string]*Carrier {
+	if x != nil {
+		return x.Carriers
+	}
+	return nil
+}
+
+func (x *Results) GetAgents() map[string]*Agent {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+func (x *Results) GetAlliances() map[string]*Alliance {
+	if x != nil {
+		return x.Alliances
+	}
+	return nil
+}
+
+type ItineraryResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PricingOptions     []*PricingOption       `protobuf:"bytes,1,rep,name=pricing_options,json=pricingOptions,proto3" json:"pricing_options,omitempty"`
+	LegIds             []string               `protobuf:"bytes,2,rep,name=leg_ids,json=legIds,proto3" json:"leg_ids,omitempty"`
+	SustainabilityData *SustainabilityData    `protobuf:"bytes,3,opt,name=sustainability_data,json=sustainabilityData,proto3" json:"sustainability_data,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ItineraryResult) Reset() {
+	*x = ItineraryResult{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItineraryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItineraryResult) ProtoMessage() {}
+
+func (x *ItineraryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItineraryResult.ProtoReflect.Descriptor instead.
+func (*ItineraryResult) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ItineraryResult) GetPricingOptions() []*PricingOption {
+	if x != nil {
+		return x.PricingOptions
+	}
+	return nil
+}
+
+func (x *ItineraryResult) GetLegIds() []string {
+	if x != nil {
+		return x.LegIds
+	}
+	return nil
+}
+
+func (x *ItineraryResult) GetSustainabilityData() *SustainabilityData {
+	if x != nil {
+		return x.SustainabilityData
+	}
+	return nil
+}
+
+type PricingOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         *Price                 `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	AgentIds      []string               `protobuf:"bytes,2,rep,name=agent_ids,json=agentIds,proto3" json:"agent_ids,omitempty"`
+	Items         []*PricingOptionItem   `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	TransferType  TransferType           `protobuf:"varint,4,opt,name=transfer_type,json=transferType,proto3,enum=skyscanner.v1.TransferType" json:"transfer_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricingOption) Reset() {
+	*x = PricingOption{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricingOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingOption) ProtoMessage() {}
+
+func (x *PricingOption) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingOption.ProtoReflect.Descriptor instead.
+func (*PricingOption) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PricingOption) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PricingOption) GetAgentIds() []string {
+	if x != nil {
+		return x.AgentIds
+	}
+	return nil
+}
+
+func (x *PricingOption) GetItems() []*PricingOptionItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PricingOption) GetTransferType() TransferType {
+	if x != nil {
+		return x.TransferType
+	}
+	return TransferType_TRANSFER_TYPE_UNSPECIFIED
+}
+
+type PricingOptionItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         *Price                 `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	AgentId       string                 `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	DeepLink      string                 `protobuf:"bytes,3,opt,name=deep_link,json=deepLink,proto3" json:"deep_link,omitempty"`
+	Fares         []*PricingOptionFare   `protobuf:"bytes,4,rep,name=fares,proto3" json:"fares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricingOptionItem) Reset() {
+	*x = PricingOptionItem{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricingOptionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingOptionItem) ProtoMessage() {}
+
+func (x *PricingOptionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingOptionItem.ProtoReflect.Descriptor instead.
+func (*PricingOptionItem) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PricingOptionItem) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PricingOptionItem) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *PricingOptionItem) GetDeepLink() string {
+	if x != nil {
+		return x.DeepLink
+	}
+	return ""
+}
+
+func (x *PricingOptionItem) GetFares() []*PricingOptionFare {
+	if x != nil {
+		return x.Fares
+	}
+	return nil
+}
+
+type PricingOptionFare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SegmentId     string                 `protobuf:"bytes,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	BookingCode   string                 `protobuf:"bytes,2,opt,name=booking_code,json=bookingCode,proto3" json:"booking_code,omitempty"`
+	FareBasisCode string                 `protobuf:"bytes,3,opt,name=fare_basis_code,json=fareBasisCode,proto3" json:"fare_basis_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricingOptionFare) Reset() {
+	*x = PricingOptionFare{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricingOptionFare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingOptionFare) ProtoMessage() {}
+
+func (x *PricingOptionFare) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingOptionFare.ProtoReflect.Descriptor instead.
+func (*PricingOptionFare) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PricingOptionFare) GetSegmentId() string {
+	if x != nil {
+		return x.SegmentId
+	}
+	return ""
+}
+
+func (x *PricingOptionFare) GetBookingCode() string {
+	if x != nil {
+		return x.BookingCode
+	}
+	return ""
+}
+
+func (x *PricingOptionFare) GetFareBasisCode() string {
+	if x != nil {
+		return x.FareBasisCode
+	}
+	return ""
+}
+
+type SustainabilityData struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	IsEcoContender    bool                   `protobuf:"varint,1,opt,name=is_eco_contender,json=isEcoContender,proto3" json:"is_eco_contender,omitempty"`
+	EcoContenderDelta float32                `protobuf:"fixed32,2,opt,name=eco_contender_delta,json=ecoContenderDelta,proto3" json:"eco_contender_delta,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SustainabilityData) Reset() {
+	*x = SustainabilityData{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SustainabilityData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SustainabilityData) ProtoMessage() {}
+
+func (x *SustainabilityData) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SustainabilityData.ProtoReflect.Descriptor instead.
+func (*SustainabilityData) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SustainabilityData) GetIsEcoContender() bool {
+	if x != nil {
+		return x.IsEcoContender
+	}
+	return false
+}
+
+func (x *SustainabilityData) GetEcoContenderDelta() float32 {
+	if x != nil {
+		return x.EcoContenderDelta
+	}
+	return 0
+}
+
+type FlightLeg struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	OriginPlaceId       string                 `protobuf:"bytes,1,opt,name=origin_place_id,json=originPlaceId,proto3" json:"origin_place_id,omitempty"`
+	DestinationPlaceId  string                 `protobuf:"bytes,2,opt,name=destination_place_id,json=destinationPlaceId,proto3" json:"destination_place_id,omitempty"`
+	DepartureDateTime   *LocalDatetime         `protobuf:"bytes,3,opt,name=departure_date_time,json=departureDateTime,proto3" json:"departure_date_time,omitempty"`
+	ArrivalDateTime     *LocalDatetime         `protobuf:"bytes,4,opt,name=arrival_date_time,json=arrivalDateTime,proto3" json:"arrival_date_time,omitempty"`
+	DurationInMinutes   int32                  `protobuf:"varint,5,opt,name=duration_in_minutes,json=durationInMinutes,proto3" json:"duration_in_minutes,omitempty"`
+	StopCount           int32                  `protobuf:"varint,6,opt,name=stop_count,json=stopCount,proto3" json:"stop_count,omitempty"`
+	MarketingCarrierIds []string               `protobuf:"bytes,7,rep,name=marketing_carrier_ids,json=marketingCarrierIds,proto3" json:"marketing_carrier_ids,omitempty"`
+	OperatingCarrierIds []string               `protobuf:"bytes,8,rep,name=operating_carrier_ids,json=operatingCarrierIds,proto3" json:"operating_carrier_ids,omitempty"`
+	SegmentIds          []string               `protobuf:"bytes,9,rep,name=segment_ids,json=segmentIds,proto3" json:"segment_ids,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *FlightLeg) Reset() {
+	*x = FlightLeg{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlightLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightLeg) ProtoMessage() {}
+
+func (x *FlightLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightLeg.ProtoReflect.Descriptor instead.
+func (*FlightLeg) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FlightLeg) GetOriginPlaceId() string {
+	if x != nil {
+		return x.OriginPlaceId
+	}
+	return ""
+}
+
+func (x *FlightLeg) GetDestinationPlaceId() string {
+	if x != nil {
+		return x.DestinationPlaceId
+	}
+	return ""
+}
+
+func (x *FlightLeg) GetDepartureDateTime() *LocalDatetime {
+	if x != nil {
+		return x.DepartureDateTime
+	}
+	return nil
+}
+
+func (x *FlightLeg) GetArrivalDateTime() *LocalDatetime {
+	if x != nil {
+		return x.ArrivalDateTime
+	}
+	return nil
+}
+
+func (x *FlightLeg) GetDurationInMinutes() int32 {
+	if x != nil {
+		return x.DurationInMinutes
+	}
+	return 0
+}
+
+func (x *FlightLeg) GetStopCount() int32 {
+	if x != nil {
+		return x.StopCount
+	}
+	return 0
+}
+
+func (x *FlightLeg) GetMarketingCarrierIds() []string {
+	if x != nil {
+		return x.MarketingCarrierIds
+	}
+	return nil
+}
+
+func (x *FlightLeg) GetOperatingCarrierIds() []string {
+	if x != nil {
+		return x.OperatingCarrierIds
+	}
+	return nil
+}
+
+func (x *FlightLeg) GetSegmentIds() []string {
+	if x != nil {
+		return x.SegmentIds
+	}
+	return nil
+}
+
+type Segment struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	OriginPlaceId         string                 `protobuf:"bytes,1,opt,name=origin_place_id,json=originPlaceId,proto3" json:"origin_place_id,omitempty"`
+	DestinationPlaceId    string                 `protobuf:"bytes,2,opt,name=destination_place_id,json=destinationPlaceId,proto3" json:"destination_place_id,omitempty"`
+	DepartureDateTime     *LocalDatetime         `protobuf:"bytes,3,opt,name=departure_date_time,json=departureDateTime,proto3" json:"departure_date_time,omitempty"`
+	ArrivalDateTime       *LocalDatetime         `protobuf:"bytes,4,opt,name=arrival_date_time,json=arrivalDateTime,proto3" json:"arrival_date_time,omitempty"`
+	DurationInMinutes     int32                  `protobuf:"varint,5,opt,name=duration_in_minutes,json=durationInMinutes,proto3" json:"duration_in_minutes,omitempty"`
+	MarketingFlightNumber string                 `protobuf:"bytes,6,opt,name=marketing_flight_number,json=marketingFlightNumber,proto3" json:"marketing_flight_number,omitempty"`
+	MarketingCarrierId    string                 `protobuf:"bytes,7,opt,name=marketing_carrier_id,json=marketingCarrierId,proto3" json:"marketing_carrier_id,omitempty"`
+	OperatingCarrierId    string                 `protobuf:"bytes,8,opt,name=operating_carrier_id,json=operatingCarrierId,proto3" json:"operating_carrier_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Segment) Reset() {
+	*x = Segment{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Segment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Segment) ProtoMessage() {}
+
+func (x *Segment) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Segment.ProtoReflect.Descriptor instead.
+func (*Segment) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Segment) GetOriginPlaceId() string {
+	if x != nil {
+		return x.OriginPlaceId
+	}
+	return ""
+}
+
+func (x *Segment) GetDestinationPlaceId() string {
+	if x != nil {
+		return x.DestinationPlaceId
+	}
+	return ""
+}
+
+func (x *Segment) GetDepartureDateTime() *LocalDatetime {
+	if x != nil {
+		return x.DepartureDateTime
+	}
+	return nil
+}
+
+func (x *Segment) GetArrivalDateTime() *LocalDatetime {
+	if x != nil {
+		return x.ArrivalDateTime
+	}
+	return nil
+}
+
+func (x *Segment) GetDurationInMinutes() int32 {
+	if x != nil {
+		return x.DurationInMinutes
+	}
+	return 0
+}
+
+func (x *Segment) GetMarketingFlightNumber() string {
+	if x != nil {
+		return x.MarketingFlightNumber
+	}
+	return ""
+}
+
+func (x *Segment) GetMarketingCarrierId() string {
+	if x != nil {
+		return x.MarketingCarrierId
+	}
+	return ""
+}
+
+func (x *Segment) GetOperatingCarrierId() string {
+	if x != nil {
+		return x.OperatingCarrierId
+	}
+	return ""
+}
+
+type Place struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityId      string                 `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type          PlaceType              `protobuf:"varint,4,opt,name=type,proto3,enum=skyscanner.v1.PlaceType" json:"type,omitempty"`
+	Iata          string                 `protobuf:"bytes,5,opt,name=iata,proto3" json:"iata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Place) Reset() {
+	*x = Place{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Place) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Place) ProtoMessage() {}
+
+func (x *Place) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Place.ProtoReflect.Descriptor instead.
+func (*Place) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Place) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *Place) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Place) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Place) GetType() PlaceType {
+	if x != nil {
+		return x.Type
+	}
+	return PlaceType_PLACE_TYPE_UNSPECIFIED
+}
+
+func (x *Place) GetIata() string {
+	if x != nil {
+		return x.Iata
+	}
+	return ""
+}
+
+type Carrier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AllianceId    string                 `protobuf:"bytes,2,opt,name=alliance_id,json=allianceId,proto3" json:"alliance_id,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Iata          string                 `protobuf:"bytes,4,opt,name=iata,proto3" json:"iata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Carrier) Reset() {
+	*x = Carrier{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Carrier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Carrier) ProtoMessage() {}
+
+func (x *Carrier) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Carrier.ProtoReflect.Descriptor instead.
+func (*Carrier) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Carrier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Carrier) GetAllianceId() string {
+	if x != nil {
+		return x.AllianceId
+	}
+	return ""
+}
+
+func (x *Carrier) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Carrier) GetIata() string {
+	if x != nil {
+		return x.Iata
+	}
+	return ""
+}
+
+type Agent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 AgentType              `protobuf:"varint,2,opt,name=type,proto3,enum=skyscanner.v1.AgentType" json:"type,omitempty"`
+	ImageUrl             string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	FeedbackCount        int32                  `protobuf:"varint,4,opt,name=feedback_count,json=feedbackCount,proto3" json:"feedback_count,omitempty"`
+	Rating               float32                `protobuf:"fixed32,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	RatingBreakdown      *AgentRatingBreakdown  `protobuf:"bytes,6,opt,name=rating_breakdown,json=ratingBreakdown,proto3" json:"rating_breakdown,omitempty"`
+	IsOptimisedForMobile bool                   `protobuf:"varint,7,opt,name=is_optimised_for_mobile,json=isOptimisedForMobile,proto3" json:"is_optimised_for_mobile,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Agent) Reset() {
+	*x = Agent{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Agent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Agent) ProtoMessage() {}
+
+func (x *Agent) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Agent.ProtoReflect.Descriptor instead.
+func (*Agent) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Agent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Agent) GetType() AgentType {
+	if x != nil {
+		return x.Type
+	}
+	return AgentType_AGENT_TYPE_UNSPECIFIED
+}
+
+func (x *Agent) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Agent) GetFeedbackCount() int32 {
+	if x != nil {
+		return x.FeedbackCount
+	}
+	return 0
+}
+
+func (x *Agent) GetRating() float32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Agent) GetRatingBreakdown() *AgentRatingBreakdown {
+	if x != nil {
+		return x.RatingBreakdown
+	}
+	return nil
+}
+
+func (x *Agent) GetIsOptimisedForMobile() bool {
+	if x != nil {
+		return x.IsOptimisedForMobile
+	}
+	return false
+}
+
+type AgentRatingBreakdown struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CustomerService float32                `protobuf:"fixed32,1,opt,name=customer_service,json=customerService,proto3" json:"customer_service,omitempty"`
+	ReliablePrices  float32                `protobuf:"fixed32,2,opt,name=reliable_prices,json=reliablePrices,proto3" json:"reliable_prices,omitempty"`
+	ClearExtraFees  float32                `protobuf:"fixed32,3,opt,name=clear_extra_fees,json=clearExtraFees,proto3" json:"clear_extra_fees,omitempty"`
+	EaseOfBooking   float32                `protobuf:"fixed32,4,opt,name=ease_of_booking,json=easeOfBooking,proto3" json:"ease_of_booking,omitempty"`
+	Other           float32                `protobuf:"fixed32,5,opt,name=other,proto3" json:"other,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AgentRatingBreakdown) Reset() {
+	*x = AgentRatingBreakdown{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentRatingBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentRatingBreakdown) ProtoMessage() {}
+
+func (x *AgentRatingBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentRatingBreakdown.ProtoReflect.Descriptor instead.
+func (*AgentRatingBreakdown) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AgentRatingBreakdown) GetCustomerService() float32 {
+	if x != nil {
+		return x.CustomerService
+	}
+	return 0
+}
+
+func (x *AgentRatingBreakdown) GetReliablePrices() float32 {
+	if x != nil {
+		return x.ReliablePrices
+	}
+	return 0
+}
+
+func (x *AgentRatingBreakdown) GetClearExtraFees() float32 {
+	if x != nil {
+		return x.ClearExtraFees
+	}
+	return 0
+}
+
+func (x *AgentRatingBreakdown) GetEaseOfBooking() float32 {
+	if x != nil {
+		return x.EaseOfBooking
+	}
+	return 0
+}
+
+func (x *AgentRatingBreakdown) GetOther() float32 {
+	if x != nil {
+		return x.Other
+	}
+	return 0
+}
+
+type Alliance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alliance) Reset() {
+	*x = Alliance{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alliance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alliance) ProtoMessage() {}
+
+func (x *Alliance) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alliance.ProtoReflect.Descriptor instead.
+func (*Alliance) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Alliance) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Stats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Itineraries   *ItineraryStats        `protobuf:"bytes,1,opt,name=itineraries,proto3" json:"itineraries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stats) Reset() {
+	*x = Stats{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats) ProtoMessage() {}
+
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Stats) GetItineraries() *ItineraryStats {
+	if x != nil {
+		return x.Itineraries
+	}
+	return nil
+}
+
+type ItineraryStats struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	MinDuration              int32                  `protobuf:"varint,1,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
+	MaxDuration              int32                  `protobuf:"varint,2,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`
+	Total                    *ItinerarySummary      `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	Stops                    *ItineraryStopStats    `protobuf:"bytes,4,opt,name=stops,proto3" json:"stops,omitempty"`
+	HasChangeAirportTransfer bool                   `protobuf:"varint,5,opt,name=has_change_airport_transfer,json=hasChangeAirportTransfer,proto3" json:"has_change_airport_transfer,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ItineraryStats) Reset() {
+	*x = ItineraryStats{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItineraryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItineraryStats) ProtoMessage() {}
+
+func (x *ItineraryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItineraryStats.ProtoReflect.Descriptor instead.
+func (*ItineraryStats) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ItineraryStats) GetMinDuration() int32 {
+	if x != nil {
+		return x.MinDuration
+	}
+	return 0
+}
+
+func (x *ItineraryStats) GetMaxDuration() int32 {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return 0
+}
+
+func (x *ItineraryStats) GetTotal() *ItinerarySummary {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *ItineraryStats) GetStops() *ItineraryStopStats {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *ItineraryStats) GetHasChangeAirportTransfer() bool {
+	if x != nil {
+		return x.HasChangeAirportTransfer
+	}
+	return false
+}
+
+type ItinerarySummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	MinPrice      *Price                 `protobuf:"bytes,2,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItinerarySummary) Reset() {
+	*x = ItinerarySummary{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItinerarySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItinerarySummary) ProtoMessage() {}
+
+func (x *ItinerarySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItinerarySummary.ProtoReflect.Descriptor instead.
+func (*ItinerarySummary) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ItinerarySummary) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ItinerarySummary) GetMinPrice() *Price {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+type ItineraryStopStats struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Direct        *ItineraryStopSummaryStats `protobuf:"bytes,1,opt,name=direct,proto3" json:"direct,omitempty"`
+	OneStop       *ItineraryStopSummaryStats `protobuf:"bytes,2,opt,name=one_stop,json=oneStop,proto3" json:"one_stop,omitempty"`
+	TwoPlusStops  *ItineraryStopSummaryStats `protobuf:"bytes,3,opt,name=two_plus_stops,json=twoPlusStops,proto3" json:"two_plus_stops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItineraryStopStats) Reset() {
+	*x = ItineraryStopStats{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItineraryStopStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItineraryStopStats) ProtoMessage() {}
+
+func (x *ItineraryStopStats) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItineraryStopStats.ProtoReflect.Descriptor instead.
+func (*ItineraryStopStats) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ItineraryStopStats) GetDirect() *ItineraryStopSummaryStats {
+	if x != nil {
+		return x.Direct
+	}
+	return nil
+}
+
+func (x *ItineraryStopStats) GetOneStop() *ItineraryStopSummaryStats {
+	if x != nil {
+		return x.OneStop
+	}
+	return nil
+}
+
+func (x *ItineraryStopStats) GetTwoPlusStops() *ItineraryStopSummaryStats {
+	if x != nil {
+		return x.TwoPlusStops
+	}
+	return nil
+}
+
+type ItineraryStopSummaryStats struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Total         *ItinerarySummary         `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	TicketTypes   *ItineraryStopTicketStats `protobuf:"bytes,2,opt,name=ticket_types,json=ticketTypes,proto3" json:"ticket_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItineraryStopSummaryStats) Reset() {
+	*x = ItineraryStopSummaryStats{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItineraryStopSummaryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItineraryStopSummaryStats) ProtoMessage() {}
+
+func (x *ItineraryStopSummaryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItineraryStopSummaryStats.ProtoReflect.Descriptor instead.
+func (*ItineraryStopSummaryStats) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ItineraryStopSummaryStats) GetTotal() *ItinerarySummary {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *ItineraryStopSummaryStats) GetTicketTypes() *ItineraryStopTicketStats {
+	if x != nil {
+		return x.TicketTypes
+	}
+	return nil
+}
+
+type ItineraryStopTicketStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SingleTicket      *ItinerarySummary      `protobuf:"bytes,1,opt,name=single_ticket,json=singleTicket,proto3" json:"single_ticket,omitempty"`
+	MultiTicketNonNpt *ItinerarySummary      `protobuf:"bytes,2,opt,name=multi_ticket_non_npt,json=multiTicketNonNpt,proto3" json:"multi_ticket_non_npt,omitempty"`
+	MultiTicketNpt    *ItinerarySummary      `protobuf:"bytes,3,opt,name=multi_ticket_npt,json=multiTicketNpt,proto3" json:"multi_ticket_npt,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ItineraryStopTicketStats) Reset() {
+	*x = ItineraryStopTicketStats{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItineraryStopTicketStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItineraryStopTicketStats) ProtoMessage() {}
+
+func (x *ItineraryStopTicketStats) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItineraryStopTicketStats.ProtoReflect.Descriptor instead.
+func (*ItineraryStopTicketStats) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ItineraryStopTicketStats) GetSingleTicket() *ItinerarySummary {
+	if x != nil {
+		return x.SingleTicket
+	}
+	return nil
+}
+
+func (x *ItineraryStopTicketStats) GetMultiTicketNonNpt() *ItinerarySummary {
+	if x != nil {
+		return x.MultiTicketNonNpt
+	}
+	return nil
+}
+
+func (x *ItineraryStopTicketStats) GetMultiTicketNpt() *ItinerarySummary {
+	if x != nil {
+		return x.MultiTicketNpt
+	}
+	return nil
+}
+
+type SortingOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Best          []*SortingOptionItem   `protobuf:"bytes,1,rep,name=best,proto3" json:"best,omitempty"`
+	Cheapest      []*SortingOptionItem   `protobuf:"bytes,2,rep,name=cheapest,proto3" json:"cheapest,omitempty"`
+	Fastest       []*SortingOptionItem   `protobuf:"bytes,3,rep,name=fastest,proto3" json:"fastest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortingOptions) Reset() {
+	*x = SortingOptions{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortingOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortingOptions) ProtoMessage() {}
+
+func (x *SortingOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortingOptions.ProtoReflect.Descriptor instead.
+func (*SortingOptions) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SortingOptions) GetBest() []*SortingOptionItem {
+	if x != nil {
+		return x.Best
+	}
+	return nil
+}
+
+func (x *SortingOptions) GetCheapest() []*SortingOptionItem {
+	if x != nil {
+		return x.Cheapest
+	}
+	return nil
+}
+
+func (x *SortingOptions) GetFastest() []*SortingOptionItem {
+	if x != nil {
+		return x.Fastest
+	}
+	return nil
+}
+
+type SortingOptionItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Score         float32                `protobuf:"fixed32,1,opt,name=score,proto3" json:"score,omitempty"`
+	ItineraryId   string                 `protobuf:"bytes,2,opt,name=itinerary_id,json=itineraryId,proto3" json:"itinerary_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortingOptionItem) Reset() {
+	*x = SortingOptionItem{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortingOptionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortingOptionItem) ProtoMessage() {}
+
+func (x *SortingOptionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortingOptionItem.ProtoReflect.Descriptor instead.
+func (*SortingOptionItem) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SortingOptionItem) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SortingOptionItem) GetItineraryId() string {
+	if x != nil {
+		return x.ItineraryId
+	}
+	return ""
+}
+
+type Locale struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Locale) Reset() {
+	*x = Locale{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Locale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Locale) ProtoMessage() {}
+
+func (x *Locale) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Locale.ProtoReflect.Descriptor instead.
+func (*Locale) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Locale) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Locale) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Currency struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	Code                        string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Symbol                      string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	ThousandsSeparator          string                 `protobuf:"bytes,3,opt,name=thousands_separator,json=thousandsSeparator,proto3" json:"thousands_separator,omitempty"`
+	DecimalSeparator            string                 `protobuf:"bytes,4,opt,name=decimal_separator,json=decimalSeparator,proto3" json:"decimal_separator,omitempty"`
+	SymbolOnLeft                bool                   `protobuf:"varint,5,opt,name=symbol_on_left,json=symbolOnLeft,proto3" json:"symbol_on_left,omitempty"`
+	SpaceBetweenAmountAndSymbol bool                   `protobuf:"varint,6,opt,name=space_between_amount_and_symbol,json=spaceBetweenAmountAndSymbol,proto3" json:"space_between_amount_and_symbol,omitempty"`
+	DecimalDigits               int32                  `protobuf:"varint,7,opt,name=decimal_digits,json=decimalDigits,proto3" json:"decimal_digits,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *Currency) Reset() {
+	*x = Currency{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Currency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Currency) ProtoMessage() {}
+
+func (x *Currency) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Currency.ProtoReflect.Descriptor instead.
+func (*Currency) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Currency) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Currency) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Currency) GetThousandsSeparator() string {
+	if x != nil {
+		return x.ThousandsSeparator
+	}
+	return ""
+}
+
+func (x *Currency) GetDecimalSeparator() string {
+	if x != nil {
+		return x.DecimalSeparator
+	}
+	return ""
+}
+
+func (x *Currency) GetSymbolOnLeft() bool {
+	if x != nil {
+		return x.SymbolOnLeft
+	}
+	return false
+}
+
+func (x *Currency) GetSpaceBetweenAmountAndSymbol() bool {
+	if x != nil {
+		return x.SpaceBetweenAmountAndSymbol
+	}
+	return false
+}
+
+func (x *Currency) GetDecimalDigits() int32 {
+	if x != nil {
+		return x.DecimalDigits
+	}
+	return 0
+}
+
+type Market struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Market) Reset() {
+	*x = Market{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Market) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Market) ProtoMessage() {}
+
+func (x *Market) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Market.ProtoReflect.Descriptor instead.
+func (*Market) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Market) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Market) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type LocalesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalesRequest) Reset() {
+	*x = LocalesRequest{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalesRequest) ProtoMessage() {}
+
+func (x *LocalesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalesRequest.ProtoReflect.Descriptor instead.
+func (*LocalesRequest) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{32}
+}
+
+type LocalesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ResultStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=skyscanner.v1.ResultStatus" json:"status,omitempty"`
+	Locales       []*Locale              `protobuf:"bytes,2,rep,name=locales,proto3" json:"locales,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalesResponse) Reset() {
+	*x = LocalesResponse{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalesResponse) ProtoMessage() {}
+
+func (x *LocalesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalesResponse.ProtoReflect.Descriptor instead.
+func (*LocalesResponse) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LocalesResponse) GetStatus() ResultStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ResultStatus_RESULT_STATUS_UNSPECIFIED
+}
+
+func (x *LocalesResponse) GetLocales() []*Locale {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
+type CurrenciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrenciesRequest) Reset() {
+	*x = CurrenciesRequest{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrenciesRequest) ProtoMessage() {}
+
+func (x *CurrenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrenciesRequest.ProtoReflect.Descriptor instead.
+func (*CurrenciesRequest) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{34}
+}
+
+type CurrenciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ResultStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=skyscanner.v1.ResultStatus" json:"status,omitempty"`
+	Currencies    []*Currency            `protobuf:"bytes,2,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrenciesResponse) Reset() {
+	*x = CurrenciesResponse{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrenciesResponse) ProtoMessage() {}
+
+func (x *CurrenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrenciesResponse.ProtoReflect.Descriptor instead.
+func (*CurrenciesResponse) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CurrenciesResponse) GetStatus() ResultStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ResultStatus_RESULT_STATUS_UNSPECIFIED
+}
+
+func (x *CurrenciesResponse) GetCurrencies() []*Currency {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+type MarketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketsRequest) Reset() {
+	*x = MarketsRequest{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsRequest) ProtoMessage() {}
+
+func (x *MarketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsRequest.ProtoReflect.Descriptor instead.
+func (*MarketsRequest) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MarketsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type MarketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ResultStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=skyscanner.v1.ResultStatus" json:"status,omitempty"`
+	Markets       []*Market              `protobuf:"bytes,2,rep,name=markets,proto3" json:"markets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketsResponse) Reset() {
+	*x = MarketsResponse{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketsResponse) ProtoMessage() {}
+
+func (x *MarketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketsResponse.ProtoReflect.Descriptor instead.
+func (*MarketsResponse) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MarketsResponse) GetStatus() ResultStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ResultStatus_RESULT_STATUS_UNSPECIFIED
+}
+
+func (x *MarketsResponse) GetMarkets() []*Market {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+type NearestCultureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IpAddress     string                 `protobuf:"bytes,1,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearestCultureRequest) Reset() {
+	*x = NearestCultureRequest{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearestCultureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearestCultureRequest) ProtoMessage() {}
+
+func (x *NearestCultureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearestCultureRequest.ProtoReflect.Descriptor instead.
+func (*NearestCultureRequest) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *NearestCultureRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+type NearestCultureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ResultStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=skyscanner.v1.ResultStatus" json:"status,omitempty"`
+	Market        *Market                `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
+	Locale        *Locale                `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	Currency      *Currency              `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearestCultureResponse) Reset() {
+	*x = NearestCultureResponse{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearestCultureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearestCultureResponse) ProtoMessage() {}
+
+func (x *NearestCultureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearestCultureResponse.ProtoReflect.Descriptor instead.
+func (*NearestCultureResponse) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *NearestCultureResponse) GetStatus() ResultStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ResultStatus_RESULT_STATUS_UNSPECIFIED
+}
+
+func (x *NearestCultureResponse) GetMarket() *Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *NearestCultureResponse) GetLocale() *Locale {
+	if x != nil {
+		return x.Locale
+	}
+	return nil
+}
+
+func (x *NearestCultureResponse) GetCurrency() *Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+type AutoSuggestFlightsRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Locale              string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	Market              string                 `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
+	SearchTerm          string                 `protobuf:"bytes,3,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	IncludedEntityTypes []PlaceType            `protobuf:"varint,4,rep,packed,name=included_entity_types,json=includedEntityTypes,proto3,enum=skyscanner.v1.PlaceType" json:"included_entity_types,omitempty"`
+	Limit               int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	IsDestination       bool                   `protobuf:"varint,6,opt,name=is_destination,json=isDestination,proto3" json:"is_destination,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AutoSuggestFlightsRequest) Reset() {
+	*x = AutoSuggestFlightsRequest{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoSuggestFlightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoSuggestFlightsRequest) ProtoMessage() {}
+
+func (x *AutoSuggestFlightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoSuggestFlightsRequest.ProtoReflect.Descriptor instead.
+func (*AutoSuggestFlightsRequest) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AutoSuggestFlightsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *AutoSuggestFlightsRequest) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *AutoSuggestFlightsRequest) GetSearchTerm() string {
+	if x != nil {
+		return x.SearchTerm
+	}
+	return ""
+}
+
+func (x *AutoSuggestFlightsRequest) GetIncludedEntityTypes() []PlaceType {
+	if x != nil {
+		return x.IncludedEntityTypes
+	}
+	return nil
+}
+
+func (x *AutoSuggestFlightsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AutoSuggestFlightsRequest) GetIsDestination() bool {
+	if x != nil {
+		return x.IsDestination
+	}
+	return false
+}
+
+type AutoSuggestFlightsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Places        []*AutoSuggestPlace    `protobuf:"bytes,1,rep,name=places,proto3" json:"places,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutoSuggestFlightsResponse) Reset() {
+	*x = AutoSuggestFlightsResponse{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoSuggestFlightsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoSuggestFlightsResponse) ProtoMessage() {}
+
+func (x *AutoSuggestFlightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoSuggestFlightsResponse.ProtoReflect.Descriptor instead.
+func (*AutoSuggestFlightsResponse) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AutoSuggestFlightsResponse) GetPlaces() []*AutoSuggestPlace {
+	if x != nil {
+		return x.Places
+	}
+	return nil
+}
+
+type AutoSuggestPlace struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EntityId    string                 `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	IataCode    string                 `protobuf:"bytes,2,opt,name=iata_code,json=iataCode,proto3" json:"iata_code,omitempty"`
+	ParentId    string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Name        string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	CountryId   string                 `protobuf:"bytes,5,opt,name=country_id,json=countryId,proto3" json:"country_id,omitempty"`
+	CountryName string                 `protobuf:"bytes,6,opt,name=country_name,json=countryName,proto3" json:"country_name,omitempty"`
+	CityName    string                 `protobuf:"bytes,7,opt,name=city_name,json=cityName,proto3" json:"city_name,omitempty"`
+	Location    string                 `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	Hierarchy   string                 `protobuf:"bytes,9,opt,name=hierarchy,proto3" json:"hierarchy,omitempty"`
+	Type        PlaceType              `protobuf:"varint,10,opt,name=type,proto3,enum=skyscanner.v1.PlaceType" json:"type,omitempty"`
+	// Highlighting is a list of [start, end) ranges of the matched term in the name
+	Highlighting       []*Highlight        `protobuf:"bytes,11,rep,name=highlighting,proto3" json:"highlighting,omitempty"`
+	AirportInformation *AirportInformation `protobuf:"bytes,12,opt,name=airport_information,json=airportInformation,proto3" json:"airport_information,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AutoSuggestPlace) Reset() {
+	*x = AutoSuggestPlace{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoSuggestPlace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoSuggestPlace) ProtoMessage() {}
+
+func (x *AutoSuggestPlace) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoSuggestPlace.ProtoReflect.Descriptor instead.
+func (*AutoSuggestPlace) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AutoSuggestPlace) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AutoSuggestPlace) GetIataCode() string {
+	if x != nil {
+		return x.IataCode
+	}
+	return ""
+}
+
+func (x *AutoSuggestPlace) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *AutoSuggestPlace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AutoSuggestPlace) GetCountryId() string {
+	if x != nil {
+		return x.CountryId
+	}
+	return ""
+}
+
+func (x *AutoSuggestPlace) GetCountryName() string {
+	if x != nil {
+		return x.CountryName
+	}
+	return ""
+}
+
+func (x *AutoSuggestPlace) GetCityName() string {
+	if x != nil {
+		return x.CityName
+	}
+	return ""
+}
+
+func (x *AutoSuggestPlace) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *AutoSuggestPlace) GetHierarchy() string {
+	if x != nil {
+		return x.Hierarchy
+	}
+	return ""
+}
+
+func (x *AutoSuggestPlace) GetType() PlaceType {
+	if x != nil {
+		return x.Type
+	}
+	return PlaceType_PLACE_TYPE_UNSPECIFIED
+}
+
+func (x *AutoSuggestPlace) GetHighlighting() []*Highlight {
+	if x != nil {
+		return x.Highlighting
+	}
+	return nil
+}
+
+func (x *AutoSuggestPlace) GetAirportInformation() *AirportInformation {
+	if x != nil {
+		return x.AirportInformation
+	}
+	return nil
+}
+
+type Highlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Range         []int32                `protobuf:"varint,1,rep,packed,name=range,proto3" json:"range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Highlight) Reset() {
+	*x = Highlight{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highlight) ProtoMessage() {}
+
+func (x *Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highlight.ProtoReflect.Descriptor instead.
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Highlight) GetRange() []int32 {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+type AirportInformation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IataCode      string                 `protobuf:"bytes,1,opt,name=iata_code,json=iataCode,proto3" json:"iata_code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CountryId     string                 `protobuf:"bytes,3,opt,name=country_id,json=countryId,proto3" json:"country_id,omitempty"`
+	CityId        string                 `protobuf:"bytes,4,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	EntityId      string                 `protobuf:"bytes,5,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,6,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Distance      *Distance              `protobuf:"bytes,7,opt,name=distance,proto3" json:"distance,omitempty"`
+	Location      string                 `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AirportInformation) Reset() {
+	*x = AirportInformation{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AirportInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AirportInformation) ProtoMessage() {}
+
+func (x *AirportInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AirportInformation.ProtoReflect.Descriptor instead.
+func (*AirportInformation) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AirportInformation) GetIataCode() string {
+	if x != nil {
+		return x.IataCode
+	}
+	return ""
+}
+
+func (x *AirportInformation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AirportInformation) GetCountryId() string {
+	if x != nil {
+		return x.CountryId
+	}
+	return ""
+}
+
+func (x *AirportInformation) GetCityId() string {
+	if x != nil {
+		return x.CityId
+	}
+	return ""
+}
+
+func (x *AirportInformation) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AirportInformation) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *AirportInformation) GetDistance() *Distance {
+	if x != nil {
+		return x.Distance
+	}
+	return nil
+}
+
+func (x *AirportInformation) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type Distance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float32                `protobuf:"fixed32,1,opt,name=value,proto3" json:"value,omitempty"`
+	UnitCode      string                 `protobuf:"bytes,2,opt,name=unit_code,json=unitCode,proto3" json:"unit_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Distance) Reset() {
+	*x = Distance{}
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Distance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Distance) ProtoMessage() {}
+
+func (x *Distance) ProtoReflect() protoreflect.Message {
+	mi := &file_skyscanner_v1_skyscanner_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Distance.ProtoReflect.Descriptor instead.
+func (*Distance) Descriptor() ([]byte, []int) {
+	return file_skyscanner_v1_skyscanner_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Distance) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Distance) GetUnitCode() string {
+	if x != nil {
+		return x.UnitCode
+	}
+	return ""
+}
+
+var File_skyscanner_v1_skyscanner_proto protoreflect.FileDescriptor
+
+const file_skyscanner_v1_skyscanner_proto_rawDesc = "" +
+	"\n" +
+	"\x1eskyscanner/v1/skyscanner.proto\x12\rskyscanner.v1\"M\n" +
+	"\x05Price\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\tR\x06amount\x12,\n" +
+	"\x04unit\x18\x02 \x01(\x0e2\x18.skyscanner.v1.PriceUnitR\x04unit\"\x8f\x01\n" +
+	"\rLocalDatetime\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x10\n" +
+	"\x03day\x18\x03 \x01(\x05R\x03day\x12\x12\n" +
+	"\x04hour\x18\x04 \x01(\x05R\x04hour\x12\x16\n" +
+	"\x06minute\x18\x05 \x01(\x05R\x06minute\x12\x16\n" +
+	"\x06second\x18\x06 \x01(\x05R\x06second\":\n" +
+	"\aPlaceId\x12\x12\n" +
+	"\x04iata\x18\x01 \x01(\tR\x04iata\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\"\xc6\x01\n" +
+	"\bQueryLeg\x12>\n" +
+	"\x0forigin_place_id\x18\x01 \x01(\v2\x16.skyscanner.v1.PlaceIdR\roriginPlaceId\x12H\n" +
+	"\x14destination_place_id\x18\x02 \x01(\v2\x16.skyscanner.v1.PlaceIdR\x12destinationPlaceId\x120\n" +
+	"\x04date\x18\x03 \x01(\v2\x1c.skyscanner.v1.LocalDatetimeR\x04date\"\xc2\x04\n" +
+	"\x12CreateRequestQuery\x12\x16\n" +
+	"\x06market\x18\x01 \x01(\tR\x06market\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x126\n" +
+	"\n" +
+	"query_legs\x18\x04 \x03(\v2\x17.skyscanner.v1.QueryLegR\tqueryLegs\x12\x16\n" +
+	"\x06adults\x18\x05 \x01(\x05R\x06adults\x122\n" +
+	"\x15included_carriers_ids\x18\x06 \x03(\tR\x13includedCarriersIds\x12:\n" +
+	"\vcabin_class\x18\a \x01(\x0e2\x19.skyscanner.v1.CabinClassR\n" +
+	"cabinClass\x12#\n" +
+	"\rchildren_ages\x18\b \x03(\x05R\fchildrenAges\x122\n" +
+	"\x15excluded_carriers_ids\x18\t \x03(\tR\x13excludedCarriersIds\x12.\n" +
+	"\x13included_agents_ids\x18\n" +
+	" \x03(\tR\x11includedAgentsIds\x12.\n" +
+	"\x13excluded_agents_ids\x18\v \x03(\tR\x11excludedAgentsIds\x12>\n" +
+	"\x1binclude_sustainability_data\x18\f \x01(\bR\x19includeSustainabilityData\x12'\n" +
+	"\x0fnearby_airports\x18\r \x01(\bR\x0enearbyAirports\"H\n" +
+	"\rSearchRequest\x127\n" +
+	"\x05query\x18\x01 \x01(\v2!.skyscanner.v1.CreateRequestQueryR\x05query\"\xac\x01\n" +
+	"\x0eSearchResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.skyscanner.v1.ResultStatusR\x06status\x123\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x1b.skyscanner.v1.ResultActionR\x06action\x120\n" +
+	"\acontent\x18\x03 \x01(\v2\x16.skyscanner.v1.ContentR\acontent\"\xaf\x01\n" +
+	"\aContent\x120\n" +
+	"\aresults\x18\x01 \x01(\v2\x16.skyscanner.v1.ResultsR\aresults\x12*\n" +
+	"\x05stats\x18\x02 \x01(\v2\x14.skyscanner.v1.StatsR\x05stats\x12F\n" +
+	"\x0fsorting_options\x18\x03 \x01(\v2\x1d.skyscanner.v1.SortingOptionsR\x0esortingOptions\"\xa1\b\n" +
+	"\aResults\x12I\n" +
+	"\vitineraries\x18\x01 \x03(\v2'.skyscanner.v1.Results.ItinerariesEntryR\vitineraries\x124\n" +
+	"\x04legs\x18\x02 \x03(\v2 .skyscanner.v1.Results.LegsEntryR\x04legs\x12@\n" +
+	"\bsegments\x18\x03 \x03(\v2$.skyscanner.v1.Results.SegmentsEntryR\bsegments\x12:\n" +
+	"\x06places\x18\x04 \x03(\v2\".skyscanner.v1.Results.PlacesEntryR\x06places\x12@\n" +
+	"\bcarriers\x18\x05 \x03(\v2$.skyscanner.v1.Results.CarriersEntryR\bcarriers\x12:\n" +
+	"\x06agents\x18\x06 \x03(\v2\".skyscanner.v1.Results.AgentsEntryR\x06agents\x12C\n" +
+	"\talliances\x18\a \x03(\v2%.skyscanner.v1.Results.AlliancesEntryR\talliances\x1a^\n" +
+	"\x10ItinerariesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x124\n" +
+	"\x05value\x18\x02 \x01(\v2\x1e.skyscanner.v1.ItineraryResultR\x05value:\x028\x01\x1aQ\n" +
+	"\tLegsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
+	"\x05value\x18\x02 \x01(\v2\x18.skyscanner.v1.FlightLegR\x05value:\x028\x01\x1aS\n" +
+	"\rSegmentsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.skyscanner.v1.SegmentR\x05value:\x028\x01\x1aO\n" +
+	"\vPlacesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.skyscanner.v1.PlaceR\x05value:\x028\x01\x1aS\n" +
+	"\rCarriersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.skyscanner.v1.CarrierR\x05value:\x028\x01\x1aO\n" +
+	"\vAgentsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.skyscanner.v1.AgentR\x05value:\x028\x01\x1aU\n" +
+	"\x0eAlliancesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\x05value\x18\x02 \x01(\v2\x17.skyscanner.v1.AllianceR\x05value:\x028\x01\"\xc5\x01\n" +
+	"\x0fItineraryResult\x12E\n" +
+	"\x0fpricing_options\x18\x01 \x03(\v2\x1c.skyscanner.v1.PricingOptionR\x0epricingOptions\x12\x17\n" +
+	"\aleg_ids\x18\x02 \x03(\tR\x06legIds\x12R\n" +
+	"\x13sustainability_data\x18\x03 \x01(\v2!.skyscanner.v1.SustainabilityDataR\x12sustainabilityData\"\xd2\x01\n" +
+	"\rPricingOption\x12*\n" +
+	"\x05price\x18\x01 \x01(\v2\x14.skyscanner.v1.PriceR\x05price\x12\x1b\n" +
+	"\tagent_ids\x18\x02 \x03(\tR\bagentIds\x126\n" +
+	"\x05items\x18\x03 \x03(\v2 .skyscanner.v1.PricingOptionItemR\x05items\x12@\n" +
+	"\rtransfer_type\x18\x04 \x01(\x0e2\x1b.skyscanner.v1.TransferTypeR\ftransferType\"\xaf\x01\n" +
+	"\x11PricingOptionItem\x12*\n" +
+	"\x05price\x18\x01 \x01(\v2\x14.skyscanner.v1.PriceR\x05price\x12\x19\n" +
+	"\bagent_id\x18\x02 \x01(\tR\aagentId\x12\x1b\n" +
+	"\tdeep_link\x18\x03 \x01(\tR\bdeepLink\x126\n" +
+	"\x05fares\x18\x04 \x03(\v2 .skyscanner.v1.PricingOptionFareR\x05fares\"}\n" +
+	"\x11PricingOptionFare\x12\x1d\n" +
+	"\n" +
+	"segment_id\x18\x01 \x01(\tR\tsegmentId\x12!\n" +
+	"\fbooking_code\x18\x02 \x01(\tR\vbookingCode\x12&\n" +
+	"\x0ffare_basis_code\x18\x03 \x01(\tR\rfareBasisCode\"n\n" +
+	"\x12SustainabilityData\x12(\n" +
+	"\x10is_eco_contender\x18\x01 \x01(\bR\x0eisEcoContender\x12.\n" +
+	"\x13eco_contender_delta\x18\x02 \x01(\x02R\x11ecoContenderDelta\"\xd5\x03\n" +
+	"\tFlightLeg\x12&\n" +
+	"\x0forigin_place_id\x18\x01 \x01(\tR\roriginPlaceId\x120\n" +
+	"\x14destination_place_id\x18\x02 \x01(\tR\x12destinationPlaceId\x12L\n" +
+	"\x13departure_date_time\x18\x03 \x01(\v2\x1c.skyscanner.v1.LocalDatetimeR\x11departureDateTime\x12H\n" +
+	"\x11arrival_date_time\x18\x04 \x01(\v2\x1c.skyscanner.v1.LocalDatetimeR\x0farrivalDateTime\x12.\n" +
+	"\x13duration_in_minutes\x18\x05 \x01(\x05R\x11durationInMinutes\x12\x1d\n" +
+	"\n" +
+	"stop_count\x18\x06 \x01(\x05R\tstopCount\x122\n" +
+	"\x15marketing_carrier_ids\x18\a \x03(\tR\x13marketingCarrierIds\x122\n" +
+	"\x15operating_carrier_ids\x18\b \x03(\tR\x13operatingCarrierIds\x12\x1f\n" +
+	"\vsegment_ids\x18\t \x03(\tR\n" +
+	"segmentIds\"\xc7\x03\n" +
+	"\aSegment\x12&\n" +
+	"\x0forigin_place_id\x18\x01 \x01(\tR\roriginPlaceId\x120\n" +
+	"\x14destination_place_id\x18\x02 \x01(\tR\x12destinationPlaceId\x12L\n" +
+	"\x13departure_date_time\x18\x03 \x01(\v2\x1c.skyscanner.v1.LocalDatetimeR\x11departureDateTime\x12H\n" +
+	"\x11arrival_date_time\x18\x04 \x01(\v2\x1c.skyscanner.v1.LocalDatetimeR\x0farrivalDateTime\x12.\n" +
+	"\x13duration_in_minutes\x18\x05 \x01(\x05R\x11durationInMinutes\x126\n" +
+	"\x17marketing_flight_number\x18\x06 \x01(\tR\x15marketingFlightNumber\x120\n" +
+	"\x14marketing_carrier_id\x18\a \x01(\tR\x12marketingCarrierId\x120\n" +
+	"\x14operating_carrier_id\x18\b \x01(\tR\x12operatingCarrierId\"\x97\x01\n" +
+	"\x05Place\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12,\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x18.skyscanner.v1.PlaceTypeR\x04type\x12\x12\n" +
+	"\x04iata\x18\x05 \x01(\tR\x04iata\"o\n" +
+	"\aCarrier\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\valliance_id\x18\x02 \x01(\tR\n" +
+	"allianceId\x12\x1b\n" +
+	"\timage_url\x18\x03 \x01(\tR\bimageUrl\x12\x12\n" +
+	"\x04iata\x18\x04 \x01(\tR\x04iata\"\xac\x02\n" +
+	"\x05Agent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12,\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x18.skyscanner.v1.AgentTypeR\x04type\x12\x1b\n" +
+	"\timage_url\x18\x03 \x01(\tR\bimageUrl\x12%\n" +
+	"\x0efeedback_count\x18\x04 \x01(\x05R\rfeedbackCount\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\x02R\x06rating\x12N\n" +
+	"\x10rating_breakdown\x18\x06 \x01(\v2#.skyscanner.v1.AgentRatingBreakdownR\x0fratingBreakdown\x125\n" +
+	"\x17is_optimised_for_mobile\x18\a \x01(\bR\x14isOptimisedForMobile\"\xd2\x01\n" +
+	"\x14AgentRatingBreakdown\x12)\n" +
+	"\x10customer_service\x18\x01 \x01(\x02R\x0fcustomerService\x12'\n" +
+	"\x0freliable_prices\x18\x02 \x01(\x02R\x0ereliablePrices\x12(\n" +
+	"\x10clear_extra_fees\x18\x03 \x01(\x02R\x0eclearExtraFees\x12&\n" +
+	"\x0fease_of_booking\x18\x04 \x01(\x02R\reaseOfBooking\x12\x14\n" +
+	"\x05other\x18\x05 \x01(\x02R\x05other\"\x1e\n" +
+	"\bAlliance\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"H\n" +
+	"\x05Stats\x12?\n" +
+	"\vitineraries\x18\x01 \x01(\v2\x1d.skyscanner.v1.ItineraryStatsR\vitineraries\"\x85\x02\n" +
+	"\x0eItineraryStats\x12!\n" +
+	"\fmin_duration\x18\x01 \x01(\x05R\vminDuration\x12!\n" +
+	"\fmax_duration\x18\x02 \x01(\x05R\vmaxDuration\x125\n" +
+	"\x05total\x18\x03 \x01(\v2\x1f.skyscanner.v1.ItinerarySummaryR\x05total\x127\n" +
+	"\x05stops\x18\x04 \x01(\v2!.skyscanner.v1.ItineraryStopStatsR\x05stops\x12=\n" +
+	"\x1bhas_change_airport_transfer\x18\x05 \x01(\bR\x18hasChangeAirportTransfer\"[\n" +
+	"\x10ItinerarySummary\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x121\n" +
+	"\tmin_price\x18\x02 \x01(\v2\x14.skyscanner.v1.PriceR\bminPrice\"\xeb\x01\n" +
+	"\x12ItineraryStopStats\x12@\n" +
+	"\x06direct\x18\x01 \x01(\v2(.skyscanner.v1.ItineraryStopSummaryStatsR\x06direct\x12C\n" +
+	"\bone_stop\x18\x02 \x01(\v2(.skyscanner.v1.ItineraryStopSummaryStatsR\aoneStop\x12N\n" +
+	"\x0etwo_plus_stops\x18\x03 \x01(\v2(.skyscanner.v1.ItineraryStopSummaryStatsR\ftwoPlusStops\"\x9e\x01\n" +
+	"\x19ItineraryStopSummaryStats\x125\n" +
+	"\x05total\x18\x01 \x01(\v2\x1f.skyscanner.v1.ItinerarySummaryR\x05total\x12J\n" +
+	"\fticket_types\x18\x02 \x01(\v2'.skyscanner.v1.ItineraryStopTicketStatsR\vticketTypes\"\xfd\x01\n" +
+	"\x18ItineraryStopTicketStats\x12D\n" +
+	"\rsingle_ticket\x18\x01 \x01(\v2\x1f.skyscanner.v1.ItinerarySummaryR\fsingleTicket\x12P\n" +
+	"\x14multi_ticket_non_npt\x18\x02 \x01(\v2\x1f.skyscanner.v1.ItinerarySummaryR\x11multiTicketNonNpt\x12I\n" +
+	"\x10multi_ticket_npt\x18\x03 \x01(\v2\x1f.skyscanner.v1.ItinerarySummaryR\x0emultiTicketNpt\"\xc0\x01\n" +
+	"\x0eSortingOptions\x124\n" +
+	"\x04best\x18\x01 \x03(\v2 .skyscanner.v1.SortingOptionItemR\x04best\x12<\n" +
+	"\bcheapest\x18\x02 \x03(\v2 .skyscanner.v1.SortingOptionItemR\bcheapest\x12:\n" +
+	"\afastest\x18\x03 \x03(\v2 .skyscanner.v1.SortingOptionItemR\afastest\"L\n" +
+	"\x11SortingOptionItem\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x02R\x05score\x12!\n" +
+	"\fitinerary_id\x18\x02 \x01(\tR\vitineraryId\"0\n" +
+	"\x06Locale\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xa7\x02\n" +
+	"\bCurrency\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12/\n" +
+	"\x13thousands_separator\x18\x03 \x01(\tR\x12thousandsSeparator\x12+\n" +
+	"\x11decimal_separator\x18\x04 \x01(\tR\x10decimalSeparator\x12$\n" +
+	"\x0esymbol_on_left\x18\x05 \x01(\bR\fsymbolOnLeft\x12D\n" +
+	"\x1fspace_between_amount_and_symbol\x18\x06 \x01(\bR\x1bspaceBetweenAmountAndSymbol\x12%\n" +
+	"\x0edecimal_digits\x18\a \x01(\x05R\rdecimalDigits\"0\n" +
+	"\x06Market\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x10\n" +
+	"\x0eLocalesRequest\"w\n" +
+	"\x0fLocalesResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.skyscanner.v1.ResultStatusR\x06status\x12/\n" +
+	"\alocales\x18\x02 \x03(\v2\x15.skyscanner.v1.LocaleR\alocales\"\x13\n" +
+	"\x11CurrenciesRequest\"\x82\x01\n" +
+	"\x12CurrenciesResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.skyscanner.v1.ResultStatusR\x06status\x127\n" +
+	"\n" +
+	"currencies\x18\x02 \x03(\v2\x17.skyscanner.v1.CurrencyR\n" +
+	"currencies\"(\n" +
+	"\x0eMarketsRequest\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\"w\n" +
+	"\x0fMarketsResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.skyscanner.v1.ResultStatusR\x06status\x12/\n" +
+	"\amarkets\x18\x02 \x03(\v2\x15.skyscanner.v1.MarketR\amarkets\"6\n" +
+	"\x15NearestCultureRequest\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x01 \x01(\tR\tipAddress\"\xe0\x01\n" +
+	"\x16NearestCultureResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.skyscanner.v1.ResultStatusR\x06status\x12-\n" +
+	"\x06market\x18\x02 \x01(\v2\x15.skyscanner.v1.MarketR\x06market\x12-\n" +
+	"\x06locale\x18\x03 \x01(\v2\x15.skyscanner.v1.LocaleR\x06locale\x123\n" +
+	"\bcurrency\x18\x04 \x01(\v2\x17.skyscanner.v1.CurrencyR\bcurrency\"\xf7\x01\n" +
+	"\x19AutoSuggestFlightsRequest\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\x12\x16\n" +
+	"\x06market\x18\x02 \x01(\tR\x06market\x12\x1f\n" +
+	"\vsearch_term\x18\x03 \x01(\tR\n" +
+	"searchTerm\x12L\n" +
+	"\x15included_entity_types\x18\x04 \x03(\x0e2\x18.skyscanner.v1.PlaceTypeR\x13includedEntityTypes\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12%\n" +
+	"\x0eis_destination\x18\x06 \x01(\bR\risDestination\"U\n" +
+	"\x1aAutoSuggestFlightsResponse\x127\n" +
+	"\x06places\x18\x01 \x03(\v2\x1f.skyscanner.v1.AutoSuggestPlaceR\x06places\"\xd6\x03\n" +
+	"\x10AutoSuggestPlace\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x12\x1b\n" +
+	"\tiata_code\x18\x02 \x01(\tR\biataCode\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"country_id\x18\x05 \x01(\tR\tcountryId\x12!\n" +
+	"\fcountry_name\x18\x06 \x01(\tR\vcountryName\x12\x1b\n" +
+	"\tcity_name\x18\a \x01(\tR\bcityName\x12\x1a\n" +
+	"\blocation\x18\b \x01(\tR\blocation\x12\x1c\n" +
+	"\thierarchy\x18\t \x01(\tR\thierarchy\x12,\n" +
+	"\x04type\x18\n" +
+	" \x01(\x0e2\x18.skyscanner.v1.PlaceTypeR\x04type\x12<\n" +
+	"\fhighlighting\x18\v \x03(\v2\x18.skyscanner.v1.HighlightR\fhighlighting\x12R\n" +
+	"\x13airport_information\x18\f \x01(\v2!.skyscanner.v1.AirportInformationR\x12airportInformation\"!\n" +
+	"\tHighlight\x12\x14\n" +
+	"\x05range\x18\x01 \x03(\x05R\x05range\"\x88\x02\n" +
+	"\x12AirportInformation\x12\x1b\n" +
+	"\tiata_code\x18\x01 \x01(\tR\biataCode\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"country_id\x18\x03 \x01(\tR\tcountryId\x12\x17\n" +
+	"\acity_id\x18\x04 \x01(\tR\x06cityId\x12\x1b\n" +
+	"\tentity_id\x18\x05 \x01(\tR\bentityId\x12\x1b\n" +
+	"\tparent_id\x18\x06 \x01(\tR\bparentId\x123\n" +
+	"\bdistance\x18\a \x01(\v2\x17.skyscanner.v1.DistanceR\bdistance\x12\x1a\n" +
+	"\blocation\x18\b \x01(\tR\blocation\"=\n" +
+	"\bDistance\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x02R\x05value\x12\x1b\n" +
+	"\tunit_code\x18\x02 \x01(\tR\bunitCode*\x94\x01\n" +
+	"\n" +
+	"CabinClass\x12\x1b\n" +
+	"\x17CABIN_CLASS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13CABIN_CLASS_ECONOMY\x10\x01\x12\x1f\n" +
+	"\x1bCABIN_CLASS_PREMIUM_ECONOMY\x10\x02\x12\x18\n" +
+	"\x14CABIN_CLASS_BUSINESS\x10\x03\x12\x15\n" +
+	"\x11CABIN_CLASS_FIRST\x10\x04*\x81\x01\n" +
+	"\fResultStatus\x12\x1d\n" +
+	"\x19RESULT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16RESULT_STATUS_COMPLETE\x10\x01\x12\x1c\n" +
+	"\x18RESULT_STATUS_INCOMPLETE\x10\x02\x12\x18\n" +
+	"\x14RESULT_STATUS_FAILED\x10\x03*\x84\x01\n" +
+	"\fResultAction\x12\x1d\n" +
+	"\x19RESULT_ACTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16RESULT_ACTION_REPLACED\x10\x01\x12\x1e\n" +
+	"\x1aRESULT_ACTION_NOT_MODIFIED\x10\x02\x12\x19\n" +
+	"\x15RESULT_ACTION_OMITTED\x10\x03*\x7f\n" +
+	"\tPriceUnit\x12\x1a\n" +
+	"\x16PRICE_UNIT_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10PRICE_UNIT_WHOLE\x10\x01\x12\x14\n" +
+	"\x10PRICE_UNIT_CENTI\x10\x02\x12\x14\n" +
+	"\x10PRICE_UNIT_MILLI\x10\x03\x12\x14\n" +
+	"\x10PRICE_UNIT_MICRO\x10\x04*\x94\x01\n" +
+	"\fTransferType\x12\x1d\n" +
+	"\x19TRANSFER_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15TRANSFER_TYPE_MANAGED\x10\x01\x12\x1f\n" +
+	"\x1bTRANSFER_TYPE_SELF_TRANSFER\x10\x02\x12)\n" +
+	"%TRANSFER_TYPE_PROTECTED_SELF_TRANSFER\x10\x03*\x86\x01\n" +
+	"\tPlaceType\x12\x1a\n" +
+	"\x16PLACE_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PLACE_TYPE_AIRPORT\x10\x01\x12\x13\n" +
+	"\x0fPLACE_TYPE_CITY\x10\x02\x12\x16\n" +
+	"\x12PLACE_TYPE_COUNTRY\x10\x03\x12\x18\n" +
+	"\x14PLACE_TYPE_CONTINENT\x10\x04*\\\n" +
+	"\tAgentType\x12\x1a\n" +
+	"\x16AGENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17AGENT_TYPE_TRAVEL_AGENT\x10\x01\x12\x16\n" +
+	"\x12AGENT_TYPE_AIRLINE\x10\x022\x88\x04\n" +
+	"\fFlightSearch\x12G\n" +
+	"\x06Search\x12\x1c.skyscanner.v1.SearchRequest\x1a\x1d.skyscanner.v1.SearchResponse0\x01\x12i\n" +
+	"\x12AutoSuggestFlights\x12(.skyscanner.v1.AutoSuggestFlightsRequest\x1a).skyscanner.v1.AutoSuggestFlightsResponse\x12H\n" +
+	"\aLocales\x12\x1d.skyscanner.v1.LocalesRequest\x1a\x1e.skyscanner.v1.LocalesResponse\x12Q\n" +
+	"\n" +
+	"Currencies\x12 .skyscanner.v1.CurrenciesRequest\x1a!.skyscanner.v1.CurrenciesResponse\x12H\n" +
+	"\aMarkets\x12\x1d.skyscanner.v1.MarketsRequest\x1a\x1e.skyscanner.v1.MarketsResponse\x12]\n" +
+	"\x0eNearestCulture\x12$.skyscanner.v1.NearestCultureRequest\x1a%.skyscanner.v1.NearestCultureResponseBAZ?github.com/VitaliyJ/skyscanner/proto/skyscanner/v1;skyscannerv1b\x06proto3"
+
+var (
+	file_skyscanner_v1_skyscanner_proto_rawDescOnce sync.Once
+	file_skyscanner_v1_skyscanner_proto_rawDescData []byte
+)
+
+func file_skyscanner_v1_skyscanner_proto_rawDescGZIP() []byte {
+	file_skyscanner_v1_skyscanner_proto_rawDescOnce.Do(func() {
+		file_skyscanner_v1_skyscanner_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_skyscanner_v1_skyscanner_proto_rawDesc), len(file_skyscanner_v1_skyscanner_proto_rawDesc)))
+	})
+	return file_skyscanner_v1_skyscanner_proto_rawDescData
+}
+
+var file_skyscanner_v1_skyscanner_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_skyscanner_v1_skyscanner_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
+var file_skyscanner_v1_skyscanner_proto_goTypes = []any{
+	(CabinClass)(0),                    // 0: skyscanner.v1.CabinClass
+	(ResultStatus)(0),                  // 1: skyscanner.v1.ResultStatus
+	(ResultAction)(0),                  // 2: skyscanner.v1.ResultAction
+	(PriceUnit)(0),                     // 3: skyscanner.v1.PriceUnit
+	(TransferType)(0),                  // 4: skyscanner.v1.TransferType
+	(PlaceType)(0),                     // 5: skyscanner.v1.PlaceType
+	(AgentType)(0),                     // 6: skyscanner.v1.AgentType
+	(*Price)(nil),                      // 7: skyscanner.v1.Price
+	(*LocalDatetime)(nil),              // 8: skyscanner.v1.LocalDatetime
+	(*PlaceId)(nil),                    // 9: skyscanner.v1.PlaceId
+	(*QueryLeg)(nil),                   // 10: skyscanner.v1.QueryLeg
+	(*CreateRequestQuery)(nil),         // 11: skyscanner.v1.CreateRequestQuery
+	(*SearchRequest)(nil),              // 12: skyscanner.v1.SearchRequest
+	(*SearchResponse)(nil),             // 13: skyscanner.v1.SearchResponse
+	(*Content)(nil),                    // 14: skyscanner.v1.Content
+	(*Results)(nil),                    // 15: skyscanner.v1.Results
+	(*ItineraryResult)(nil),            // 16: skyscanner.v1.ItineraryResult
+	(*PricingOption)(nil),              // 17: skyscanner.v1.PricingOption
+	(*PricingOptionItem)(nil),          // 18: skyscanner.v1.PricingOptionItem
+	(*PricingOptionFare)(nil),          // 19: skyscanner.v1.PricingOptionFare
+	(*SustainabilityData)(nil),         // 20: skyscanner.v1.SustainabilityData
+	(*FlightLeg)(nil),                  // 21: skyscanner.v1.FlightLeg
+	(*Segment)(nil),                    // 22: skyscanner.v1.Segment
+	(*Place)(nil),                      // 23: skyscanner.v1.Place
+	(*Carrier)(nil),                    // 24: skyscanner.v1.Carrier
+	(*Agent)(nil),                      // 25: skyscanner.v1.Agent
+	(*AgentRatingBreakdown)(nil),       // 26: skyscanner.v1.AgentRatingBreakdown
+	(*Alliance)(nil),                   // 27: skyscanner.v1.Alliance
+	(*Stats)(nil),                      // 28: skyscanner.v1.Stats
+	(*ItineraryStats)(nil),             // 29: skyscanner.v1.ItineraryStats
+	(*ItinerarySummary)(nil),           // 30: skyscanner.v1.ItinerarySummary
+	(*ItineraryStopStats)(nil),         // 31: skyscanner.v1.ItineraryStopStats
+	(*ItineraryStopSummaryStats)(nil),  // 32: skyscanner.v1.ItineraryStopSummaryStats
+	(*ItineraryStopTicketStats)(nil),   // 33: skyscanner.v1.ItineraryStopTicketStats
+	(*SortingOptions)(nil),             // 34: skyscanner.v1.SortingOptions
+	(*SortingOptionItem)(nil),          // 35: skyscanner.v1.SortingOptionItem
+	(*Locale)(nil),                     // 36: skyscanner.v1.Locale
+	(*Currency)(nil),                   // 37: skyscanner.v1.Currency
+	(*Market)(nil),                     // 38: skyscanner.v1.Market
+	(*LocalesRequest)(nil),             // 39: skyscanner.v1.LocalesRequest
+	(*LocalesResponse)(nil),            // 40: skyscanner.v1.LocalesResponse
+	(*CurrenciesRequest)(nil),          // 41: skyscanner.v1.CurrenciesRequest
+	(*CurrenciesResponse)(nil),         // 42: skyscanner.v1.CurrenciesResponse
+	(*MarketsRequest)(nil),             // 43: skyscanner.v1.MarketsRequest
+	(*MarketsResponse)(nil),            // 44: skyscanner.v1.MarketsResponse
+	(*NearestCultureRequest)(nil),      // 45: skyscanner.v1.NearestCultureRequest
+	(*NearestCultureResponse)(nil),     // 46: skyscanner.v1.NearestCultureResponse
+	(*AutoSuggestFlightsRequest)(nil),  // 47: skyscanner.v1.AutoSuggestFlightsRequest
+	(*AutoSuggestFlightsResponse)(nil), // 48: skyscanner.v1.AutoSuggestFlightsResponse
+	(*AutoSuggestPlace)(nil),           // 49: skyscanner.v1.AutoSuggestPlace
+	(*Highlight)(nil),                  // 50: skyscanner.v1.Highlight
+	(*AirportInformation)(nil),         // 51: skyscanner.v1.AirportInformation
+	(*Distance)(nil),                   // 52: skyscanner.v1.Distance
+	nil,                                // 53: skyscanner.v1.Results.ItinerariesEntry
+	nil,                                // 54: skyscanner.v1.Results.LegsEntry
+	nil,                                // 55: skyscanner.v1.Results.SegmentsEntry
+	nil,                                // 56: skyscanner.v1.Results.PlacesEntry
+	nil,                                // 57: skyscanner.v1.Results.CarriersEntry
+	nil,                                // 58: skyscanner.v1.Results.AgentsEntry
+	nil,                                // 59: skyscanner.v1.Results.AlliancesEntry
+}
+var file_skyscanner_v1_skyscanner_proto_depIdxs = []int32{
+	3,  // 0: skyscanner.v1.Price.unit:type_name -> skyscanner.v1.PriceUnit
+	9,  // 1: skyscanner.v1.QueryLeg.origin_place_id:type_name -> skyscanner.v1.PlaceId
+	9,  // 2: skyscanner.v1.QueryLeg.destination_place_id:type_name -> skyscanner.v1.PlaceId
+	8,  // 3: skyscanner.v1.QueryLeg.date:type_name -> skyscanner.v1.LocalDatetime
+	10, // 4: skyscanner.v1.CreateRequestQuery.query_legs:type_name -> skyscanner.v1.QueryLeg
+	0,  // 5: skyscanner.v1.CreateRequestQuery.cabin_class:type_name -> skyscanner.v1.CabinClass
+	11, // 6: skyscanner.v1.SearchRequest.query:type_name -> skyscanner.v1.CreateRequestQuery
+	1,  // 7: skyscanner.v1.SearchResponse.status:type_name -> skyscanner.v1.ResultStatus
+	2,  // 8: skyscanner.v1.SearchResponse.action:type_name -> skyscanner.v1.ResultAction
+	14, // 9: skyscanner.v1.SearchResponse.content:type_name -> skyscanner.v1.Content
+	15, // 10: skyscanner.v1.Content.results:type_name -> skyscanner.v1.Results
+	28, // 11: skyscanner.v1.Content.stats:type_name -> skyscanner.v1.Stats
+	34, // 12: skyscanner.v1.Content.sorting_options:type_name -> skyscanner.v1.SortingOptions
+	53, // 13: skyscanner.v1.Results.itineraries:type_name -> skyscanner.v1.Results.ItinerariesEntry
+	54, // 14: skyscanner.v1.Results.legs:type_name -> skyscanner.v1.Results.LegsEntry
+	55, // 15: skyscanner.v1.Results.segments:type_name -> skyscanner.v1.Results.SegmentsEntry
+	56, // 16: skyscanner.v1.Results.places:type_name -> skyscanner.v1.Results.PlacesEntry
+	57, // 17: skyscanner.v1.Results.carriers:type_name -> skyscanner.v1.Results.CarriersEntry
+	58, // 18: skyscanner.v1.Results.agents:type_name -> skyscanner.v1.Results.AgentsEntry
+	59, // 19: skyscanner.v1.Results.alliances:type_name -> skyscanner.v1.Results.AlliancesEntry
+	17, // 20: skyscanner.v1.ItineraryResult.pricing_options:type_name -> skyscanner.v1.PricingOption
+	20, // 21: skyscanner.v1.ItineraryResult.sustainability_data:type_name -> skyscanner.v1.SustainabilityData
+	7,  // 22: skyscanner.v1.PricingOption.price:type_name -> skyscanner.v1.Price
+	18, // 23: skyscanner.v1.PricingOption.items:type_name -> skyscanner.v1.PricingOptionItem
+	4,  // 24: skyscanner.v1.PricingOption.transfer_type:type_name -> skyscanner.v1.TransferType
+	7,  // 25: skyscanner.v1.PricingOptionItem.price:type_name -> skyscanner.v1.Price
+	19, // 26: skyscanner.v1.PricingOptionItem.fares:type_name -> skyscanner.v1.PricingOptionFare
+	8,  // 27: skyscanner.v1.FlightLeg.departure_date_time:type_name -> skyscanner.v1.LocalDatetime
+	8,  // 28: skyscanner.v1.FlightLeg.arrival_date_time:type_name -> skyscanner.v1.LocalDatetime
+	8,  // 29: skyscanner.v1.Segment.departure_date_time:type_name -> skyscanner.v1.LocalDatetime
+	8,  // 30: skyscanner.v1.Segment.arrival_date_time:type_name -> skyscanner.v1.LocalDatetime
+	5,  // 31: skyscanner.v1.Place.type:type_name -> skyscanner.v1.PlaceType
+	6,  // 32: skyscanner.v1.Agent.type:type_name -> skyscanner.v1.AgentType
+	26, // 33: skyscanner.v1.Agent.rating_breakdown:type_name -> skyscanner.v1.AgentRatingBreakdown
+	29, // 34: skyscanner.v1.Stats.itineraries:type_name -> skyscanner.v1.ItineraryStats
+	30, // 35: skyscanner.v1.ItineraryStats.total:type_name -> skyscanner.v1.ItinerarySummary
+	31, // 36: skyscanner.v1.ItineraryStats.stops:type_name -> skyscanner.v1.ItineraryStopStats
+	7,  // 37: skyscanner.v1.ItinerarySummary.min_price:type_name -> skyscanner.v1.Price
+	32, // 38: skyscanner.v1.ItineraryStopStats.direct:type_name -> skyscanner.v1.ItineraryStopSummaryStats
+	32, // 39: skyscanner.v1.ItineraryStopStats.one_stop:type_name -> skyscanner.v1.ItineraryStopSummaryStats
+	32, // 40: skyscanner.v1.ItineraryStopStats.two_plus_stops:type_name -> skyscanner.v1.ItineraryStopSummaryStats
+	30, // 41: skyscanner.v1.ItineraryStopSummaryStats.total:type_name -> skyscanner.v1.ItinerarySummary
+	33, // 42: skyscanner.v1.ItineraryStopSummaryStats.ticket_types:type_name -> skyscanner.v1.ItineraryStopTicketStats
+	30, // 43: skyscanner.v1.ItineraryStopTicketStats.single_ticket:type_name -> skyscanner.v1.ItinerarySummary
+	30, // 44: skyscanner.v1.ItineraryStopTicketStats.multi_ticket_non_npt:type_name -> skyscanner.v1.ItinerarySummary
+	30, // 45: skyscanner.v1.ItineraryStopTicketStats.multi_ticket_npt:type_name -> skyscanner.v1.ItinerarySummary
+	35, // 46: skyscanner.v1.SortingOptions.best:type_name -> skyscanner.v1.SortingOptionItem
+	35, // 47: skyscanner.v1.SortingOptions.cheapest:type_name -> skyscanner.v1.SortingOptionItem
+	35, // 48: skyscanner.v1.SortingOptions.fastest:type_name -> skyscanner.v1.SortingOptionItem
+	1,  // 49: skyscanner.v1.LocalesResponse.status:type_name -> skyscanner.v1.ResultStatus
+	36, // 50: skyscanner.v1.LocalesResponse.locales:type_name -> skyscanner.v1.Locale
+	1,  // 51: skyscanner.v1.CurrenciesResponse.status:type_name -> skyscanner.v1.ResultStatus
+	37, // 52: skyscanner.v1.CurrenciesResponse.currencies:type_name -> skyscanner.v1.Currency
+	1,  // 53: skyscanner.v1.MarketsResponse.status:type_name -> skyscanner.v1.ResultStatus
+	38, // 54: skyscanner.v1.MarketsResponse.markets:type_name -> skyscanner.v1.Market
+	1,  // 55: skyscanner.v1.NearestCultureResponse.status:type_name -> skyscanner.v1.ResultStatus
+	38, // 56: skyscanner.v1.NearestCultureResponse.market:type_name -> skyscanner.v1.Market
+	36, // 57: skyscanner.v1.NearestCultureResponse.locale:type_name -> skyscanner.v1.Locale
+	37, // 58: skyscanner.v1.NearestCultureResponse.currency:type_name -> skyscanner.v1.Currency
+	5,  // 59: skyscanner.v1.AutoSuggestFlightsRequest.included_entity_types:type_name -> skyscanner.v1.PlaceType
+	49, // 60: skyscanner.v1.AutoSuggestFlightsResponse.places:type_name -> skyscanner.v1.AutoSuggestPlace
+	5,  // 61: skyscanner.v1.AutoSuggestPlace.type:type_name -> skyscanner.v1.PlaceType
+	50, // 62: skyscanner.v1.AutoSuggestPlace.highlighting:type_name -> skyscanner.v1.Highlight
+	51, // 63: skyscanner.v1.AutoSuggestPlace.airport_information:type_name -> skyscanner.v1.AirportInformation
+	52, // 64: skyscanner.v1.AirportInformation.distance:type_name -> skyscanner.v1.Distance
+	16, // 65: skyscanner.v1.Results.ItinerariesEntry.value:type_name -> skyscanner.v1.ItineraryResult
+	21, // 66: skyscanner.v1.Results.LegsEntry.value:type_name -> skyscanner.v1.FlightLeg
+	22, // 67: skyscanner.v1.Results.SegmentsEntry.value:type_name -> skyscanner.v1.Segment
+	23, // 68: skyscanner.v1.Results.PlacesEntry.value:type_name -> skyscanner.v1.Place
+	24, // 69: skyscanner.v1.Results.CarriersEntry.value:type_name -> skyscanner.v1.Carrier
+	25, // 70: skyscanner.v1.Results.AgentsEntry.value:type_name -> skyscanner.v1.Agent
+	27, // 71: skyscanner.v1.Results.AlliancesEntry.value:type_name -> skyscanner.v1.Alliance
+	12, // 72: skyscanner.v1.FlightSearch.Search:input_type -> skyscanner.v1.SearchRequest
+	47, // 73: skyscanner.v1.FlightSearch.AutoSuggestFlights:input_type -> skyscanner.v1.AutoSuggestFlightsRequest
+	39, // 74: skyscanner.v1.FlightSearch.Locales:input_type -> skyscanner.v1.LocalesRequest
+	41, // 75: skyscanner.v1.FlightSearch.Currencies:input_type -> skyscanner.v1.CurrenciesRequest
+	43, // 76: skyscanner.v1.FlightSearch.Markets:input_type -> skyscanner.v1.MarketsRequest
+	45, // 77: skyscanner.v1.FlightSearch.NearestCulture:input_type -> skyscanner.v1.NearestCultureRequest
+	13, // 78: skyscanner.v1.FlightSearch.Search:output_type -> skyscanner.v1.SearchResponse
+	48, // 79: skyscanner.v1.FlightSearch.AutoSuggestFlights:output_type -> skyscanner.v1.AutoSuggestFlightsResponse
+	40, // 80: skyscanner.v1.FlightSearch.Locales:output_type -> skyscanner.v1.LocalesResponse
+	42, // 81: skyscanner.v1.FlightSearch.Currencies:output_type -> skyscanner.v1.CurrenciesResponse
+	44, // 82: skyscanner.v1.FlightSearch.Markets:output_type -> skyscanner.v1.MarketsResponse
+	46, // 83: skyscanner.v1.FlightSearch.NearestCulture:output_type -> skyscanner.v1.NearestCultureResponse
+	78, // [78:84] is the sub-list for method output_type
+	72, // [72:78] is the sub-list for method input_type
+	72, // [72:72] is the sub-list for extension type_name
+	72, // [72:72] is the sub-list for extension extendee
+	0,  // [0:72] is the sub-list for field type_name
+}
+
+func init() { file_skyscanner_v1_skyscanner_proto_init() }
+func file_skyscanner_v1_skyscanner_proto_init() {
+	if File_skyscanner_v1_skyscanner_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_skyscanner_v1_skyscanner_proto_rawDesc), len(file_skyscanner_v1_skyscanner_proto_rawDesc)),
+			NumEnums:      7,
+			NumMessages:   53,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_skyscanner_v1_skyscanner_proto_goTypes,
+		DependencyIndexes: file_skyscanner_v1_skyscanner_proto_depIdxs,
+		EnumInfos:         file_skyscanner_v1_skyscanner_proto_enumTypes,
+		MessageInfos:      file_skyscanner_v1_skyscanner_proto_msgTypes,
+	}.Build()
+	File_skyscanner_v1_skyscanner_proto = out.File
+	file_skyscanner_v1_skyscanner_proto_goTypes = nil
+	file_skyscanner_v1_skyscanner_proto_depIdxs = nil
+}
@@ -0,0 +1,326 @@
+// Protobuf definitions mirroring the SDK request and response types.
+// Enum value names match the SDK string constants, so they convert by name.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: skyscanner/v1/skyscanner.proto
+
+package skyscannerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FlightSearch_Search_FullMethodName             = "/skyscanner.v1.FlightSearch/Search"
+	FlightSearch_AutoSuggestFlights_FullMethodName = "/skyscanner.v1.FlightSearch/AutoSuggestFlights"
+	FlightSearch_Locales_FullMethodName            = "/skyscanner.v1.FlightSearch/Locales"
+	FlightSearch_Currencies_FullMethodName         = "/skyscanner.v1.FlightSearch/Currencies"
+	FlightSearch_Markets_FullMethodName            = "/skyscanner.v1.FlightSearch/Markets"
+	FlightSearch_NearestCulture_FullMethodName     = "/skyscanner.v1.FlightSearch/NearestCulture"
+)
+
+// FlightSearchClient is the client API for FlightSearch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FlightSearch wraps the SDK Client
+type FlightSearchClient interface {
+	// Search creates a search session and streams every poll result until the search is complete.
+	// Each message carries the full accumulated content, session tokens are not exposed
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SearchResponse], error)
+	AutoSuggestFlights(ctx context.Context, in *AutoSuggestFlightsRequest, opts ...grpc.CallOption) (*AutoSuggestFlightsResponse, error)
+	Locales(ctx context.Context, in *LocalesRequest, opts ...grpc.CallOption) (*LocalesResponse, error)
+	Currencies(ctx context.Context, in *CurrenciesRequest, opts ...grpc.CallOption) (*CurrenciesResponse, error)
+	Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error)
+	NearestCulture(ctx context.Context, in *NearestCultureRequest, opts ...grpc.CallOption) (*NearestCultureResponse, error)
+}
+
+type flightSearchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFlightSearchClient(cc grpc.ClientConnInterface) FlightSearchClient {
+	return &flightSearchClient{cc}
+}
+
+func (c *flightSearchClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SearchResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FlightSearch_ServiceDesc.Streams[0], FlightSearch_Search_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SearchRequest, SearchResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FlightSearch_SearchClient = grpc.ServerStreamingClient[SearchResponse]
+
+func (c *flightSearchClient) AutoSuggestFlights(ctx context.Context, in *AutoSuggestFlightsRequest, opts ...grpc.CallOption) (*AutoSuggestFlightsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AutoSuggestFlightsResponse)
+	err := c.cc.Invoke(ctx, FlightSearch_AutoSuggestFlights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flightSearchClient) Locales(ctx context.Context, in *LocalesRequest, opts ...grpc.CallOption) (*LocalesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LocalesResponse)
+	err := c.cc.Invoke(ctx, FlightSearch_Locales_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flightSearchClient) Currencies(ctx context.Context, in *CurrenciesRequest, opts ...grpc.CallOption) (*CurrenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CurrenciesResponse)
+	err := c.cc.Invoke(ctx, FlightSearch_Currencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flightSearchClient) Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketsResponse)
+	err := c.cc.Invoke(ctx, FlightSearch_Markets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flightSearchClient) NearestCulture(ctx context.Context, in *NearestCultureRequest, opts ...grpc.CallOption) (*NearestCultureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NearestCultureResponse)
+	err := c.cc.Invoke(ctx, FlightSearch_NearestCulture_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FlightSearchServer is the server API for FlightSearch service.
+// All implementations must embed UnimplementedFlightSearchServer
+// for forward compatibility.
+//
+// FlightSearch wraps the SDK Client
+type FlightSearchServer interface {
+	// Search creates a search session and streams every poll result until the search is complete.
+	// Each message carries the full accumulated content, session tokens are not exposed
+	Search(*SearchRequest, grpc.ServerStreamingServer[SearchResponse]) error
+	AutoSuggestFlights(context.Context, *AutoSuggestFlightsRequest) (*AutoSuggestFlightsResponse, error)
+	Locales(context.Context, *LocalesRequest) (*LocalesResponse, error)
+	Currencies(context.Context, *CurrenciesRequest) (*CurrenciesResponse, error)
+	Markets(context.Context, *MarketsRequest) (*MarketsResponse, error)
+	NearestCulture(context.Context, *NearestCultureRequest) (*NearestCultureResponse, error)
+	mustEmbedUnimplementedFlightSearchServer()
+}
+
+// UnimplementedFlightSearchServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFlightSearchServer struct{}
+
+func (UnimplementedFlightSearchServer) Search(*SearchRequest, grpc.ServerStreamingServer[SearchResponse]) error {
+	return status.Error(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedFlightSearchServer) AutoSuggestFlights(context.Context, *AutoSuggestFlightsRequest) (*AutoSuggestFlightsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AutoSuggestFlights not implemented")
+}
+func (UnimplementedFlightSearchServer) Locales(context.Context, *LocalesRequest) (*LocalesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Locales not implemented")
+}
+func (UnimplementedFlightSearchServer) Currencies(context.Context, *CurrenciesRequest) (*CurrenciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Currencies not implemented")
+}
+func (UnimplementedFlightSearchServer) Markets(context.Context, *MarketsRequest) (*MarketsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Markets not implemented")
+}
+func (UnimplementedFlightSearchServer) NearestCulture(context.Context, *NearestCultureRequest) (*NearestCultureResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method NearestCulture not implemented")
+}
+func (UnimplementedFlightSearchServer) mustEmbedUnimplementedFlightSearchServer() {}
+func (UnimplementedFlightSearchServer) testEmbeddedByValue()                      {}
+
+// UnsafeFlightSearchServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FlightSearchServer will
+// result in compilation errors.
+type UnsafeFlightSearchServer interface {
+	mustEmbedUnimplementedFlightSearchServer()
+}
+
+func RegisterFlightSearchServer(s grpc.ServiceRegistrar, srv FlightSearchServer) {
+	// If the following call panics, it indicates UnimplementedFlightSearchServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FlightSearch_ServiceDesc, srv)
+}
+
+func _FlightSearch_Search_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlightSearchServer).Search(m, &grpc.GenericServerStream[SearchRequest, SearchResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FlightSearch_SearchServer = grpc.ServerStreamingServer[SearchResponse]
+
+func _FlightSearch_AutoSuggestFlights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoSuggestFlightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlightSearchServer).AutoSuggestFlights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlightSearch_AutoSuggestFlights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlightSearchServer).AutoSuggestFlights(ctx, req.(*AutoSuggestFlightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlightSearch_Locales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocalesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlightSearchServer).Locales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlightSearch_Locales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlightSearchServer).Locales(ctx, req.(*LocalesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlightSearch_Currencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlightSearchServer).Currencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlightSearch_Currencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlightSearchServer).Currencies(ctx, req.(*CurrenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlightSearch_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlightSearchServer).Markets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlightSearch_Markets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlightSearchServer).Markets(ctx, req.(*MarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlightSearch_NearestCulture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NearestCultureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlightSearchServer).NearestCulture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlightSearch_NearestCulture_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlightSearchServer).NearestCulture(ctx, req.(*NearestCultureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FlightSearch_ServiceDesc is the grpc.ServiceDesc for FlightSearch service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FlightSearch_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "skyscanner.v1.FlightSearch",
+	HandlerType: (*FlightSearchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AutoSuggestFlights",
+			Handler:    _FlightSearch_AutoSuggestFlights_Handler,
+		},
+		{
+			MethodName: "Locales",
+			Handler:    _FlightSearch_Locales_Handler,
+		},
+		{
+			MethodName: "Currencies",
+			Handler:    _FlightSearch_Currencies_Handler,
+		},
+		{
+			MethodName: "Markets",
+			Handler:    _FlightSearch_Markets_Handler,
+		},
+		{
+			MethodName: "NearestCulture",
+			Handler:    _FlightSearch_NearestCulture_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Search",
+			Handler:       _FlightSearch_Search_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "skyscanner/v1/skyscanner.proto",
+}
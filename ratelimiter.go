@@ -0,0 +1,49 @@
+package skyscanner
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// NewRateLimiter returns a RateLimiter allowing n requests per the period evenly spaced in time.
+// E.g. NewRateLimiter(100, time.Minute) allows a request every 600ms
+func NewRateLimiter(n int, per time.Duration) RateLimiter {
+	if n <= 0 {
+		n = 1
+	}
+
+	return &rateLimiter{interval: per / time.Duration(n)}
+}
+
+// Wait blocks until the next request is allowed or the context is done
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
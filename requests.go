@@ -1,5 +1,14 @@
 package skyscanner
 
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// DateLayout is the date format accepted by NewCreateRequest
+const DateLayout = "2006-01-02"
+
 // CreateRequest contains Create request attributes
 type CreateRequest struct {
 	Query *CreateRequestQuery `json:"query"`
@@ -68,3 +77,41 @@ type AutoSuggestFlightsRequestQuery struct {
 	// List of entity types to be returned. If empty, all entity types will be returned
 	IncludedEntityTypes []PlaceType `json:"includedEntityTypes,omitempty"`
 }
+
+// ParsePlaceID treats three letter codes as IATA codes and anything else as entity IDs
+func ParsePlaceID(s string) PlaceID {
+	if len(s) == 3 {
+		return PlaceID{IATA: strings.ToUpper(s)}
+	}
+
+	return PlaceID{EntityId: s}
+}
+
+// NewCreateRequest returns a one way or, if returnDate is not empty, a return search request for one adult.
+// Origin and destination are parsed with ParsePlaceID, dates are in the DateLayout format.
+// Market, locale and currency are left for the caller to set
+func NewCreateRequest(origin, destination, date, returnDate string) (*CreateRequest, error) {
+	if origin == "" || destination == "" {
+		return nil, errors.New("origin and destination are required")
+	}
+
+	outbound, err := time.Parse(DateLayout, date)
+	if err != nil {
+		return nil, errors.New("invalid date: " + err.Error())
+	}
+
+	from, to := ParsePlaceID(origin), ParsePlaceID(destination)
+	legs := []*QueryLeg{newQueryLeg(from, to, NewLocalDatetime(outbound))}
+	if returnDate != "" {
+		inbound, err := time.Parse(DateLayout, returnDate)
+		if err != nil {
+			return nil, errors.New("invalid returnDate: " + err.Error())
+		}
+		if inbound.Before(outbound) {
+			return nil, errors.New("returnDate is before date")
+		}
+		legs = append(legs, newQueryLeg(to, from, NewLocalDatetime(inbound)))
+	}
+
+	return &CreateRequest{Query: &CreateRequestQuery{QueryLegs: legs, Adults: 1}}, nil
+}
@@ -0,0 +1,107 @@
+package skyscanner
+
+// ResolvedItinerary is an itinerary with all the ID references replaced by the referenced objects
+type ResolvedItinerary struct {
+	ID                 string             `json:"id"`
+	Legs               []ResolvedLeg      `json:"legs"`
+	PricingOptions     []PricingOption    `json:"pricingOptions"`
+	SustainabilityData SustainabilityData `json:"sustainabilityData"`
+	// Emissions are set by EmissionsCalculator.Estimate
+	Emissions *ItineraryEmissions `json:"emissions,omitempty"`
+}
+
+// ResolvedLeg is a leg with resolved places, carriers and segments
+type ResolvedLeg struct {
+	ID                string            `json:"id"`
+	Origin            Place             `json:"origin"`
+	Destination       Place             `json:"destination"`
+	DepartureDateTime LocalDatetime     `json:"departureDateTime"`
+	ArrivalDateTime   LocalDatetime     `json:"arrivalDateTime"`
+	DurationInMinutes int32             `json:"durationInMinutes"`
+	StopCount         int32             `json:"stopCount"`
+	MarketingCarriers []Carrier         `json:"marketingCarriers"`
+	OperatingCarriers []Carrier         `json:"operatingCarriers"`
+	Segments          []ResolvedSegment `json:"segments"`
+}
+
+// ResolvedSegment is a segment with resolved places and carriers
+type ResolvedSegment struct {
+	ID                    string        `json:"id"`
+	Origin                Place         `json:"origin"`
+	Destination           Place         `json:"destination"`
+	DepartureDateTime     LocalDatetime `json:"departureDateTime"`
+	ArrivalDateTime       LocalDatetime `json:"arrivalDateTime"`
+	DurationInMinutes     int32         `json:"durationInMinutes"`
+	MarketingFlightNumber string        `json:"marketingFlightNumber"`
+	MarketingCarrier      Carrier       `json:"marketingCarrier"`
+	OperatingCarrier      Carrier       `json:"operatingCarrier"`
+}
+
+// ResolveItinerary returns the itinerary with resolved references.
+// Unknown legs and segments are skipped, unknown places and carriers are left empty.
+// Returns nil if the itinerary is unknown
+func (r *Results) ResolveItinerary(id string) *ResolvedItinerary {
+	it, ok := r.Itineraries[id]
+	if !ok {
+		return nil
+	}
+
+	ri := &ResolvedItinerary{
+		ID:                 id,
+		Legs:               make([]ResolvedLeg, 0, len(it.LegIds)),
+		PricingOptions:     it.PricingOptions,
+		SustainabilityData: it.SustainabilityData,
+	}
+	for _, legID := range it.LegIds {
+		leg, ok := r.Legs[legID]
+		if !ok {
+			continue
+		}
+
+		rl := ResolvedLeg{
+			ID:                legID,
+			Origin:            r.Places[leg.OriginPlaceID],
+			Destination:       r.Places[leg.DestinationPlaceID],
+			DepartureDateTime: leg.DepartureDateTime,
+			ArrivalDateTime:   leg.ArrivalDateTime,
+			DurationInMinutes: leg.DurationInMinutes,
+			StopCount:         leg.StopCount,
+			MarketingCarriers: r.carriers(leg.MarketingCarrierIds),
+			OperatingCarriers: r.carriers(leg.OperatingCarrierIds),
+			Segments:          make([]ResolvedSegment, 0, len(leg.SegmentIds)),
+		}
+		for _, segmentID := range leg.SegmentIds {
+			s, ok := r.Segments[segmentID]
+			if !ok {
+				continue
+			}
+
+			rl.Segments = append(rl.Segments, ResolvedSegment{
+				ID:                    segmentID,
+				Origin:                r.Places[s.OriginPlaceID],
+				Destination:           r.Places[s.DestinationPlaceID],
+				DepartureDateTime:     s.DepartureDateTime,
+				ArrivalDateTime:       s.ArrivalDateTime,
+				DurationInMinutes:     s.DurationInMinutes,
+				MarketingFlightNumber: s.MarketingFlightNumber,
+				MarketingCarrier:      r.Carriers[s.MarketingCarrierId],
+				OperatingCarrier:      r.Carriers[s.OperatingCarrierId],
+			})
+		}
+
+		ri.Legs = append(ri.Legs, rl)
+	}
+
+	return ri
+}
+
+func (r *Results) carriers(ids []string) []Carrier {
+	carriers := make([]Carrier, 0, len(ids))
+	for _, id := range ids {
+		if c, ok := r.Carriers[id]; ok {
+			carriers = append(carriers, c)
+		}
+	}
+
+	return carriers
+}
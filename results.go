@@ -1,9 +1,23 @@
 package skyscanner
 
+import "strconv"
+
 // ErrorResponse contains error response data
 type ErrorResponse struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
+	// Err is the cause of a request which was not completed, e.g. *CircuitOpenError
+	Err error `json:"-"`
+}
+
+// Error implements the error interface
+func (e *ErrorResponse) Error() string {
+	return strconv.Itoa(e.Code) + ": " + e.Message
+}
+
+// Unwrap returns the cause of the error
+func (e *ErrorResponse) Unwrap() error {
+	return e.Err
 }
 
 // CreatePollResponse contains Create response data
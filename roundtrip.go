@@ -0,0 +1,271 @@
+package skyscanner
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+const (
+	TripTicketingSingle   TripTicketing = "SINGLE_TICKET"    // a native search itinerary booked at once
+	TripTicketingSeparate TripTicketing = "SEPARATE_TICKETS" // one way itineraries booked separately
+
+	defaultComposeCandidates = 50
+)
+
+// TripTicketing tells how a trip option is booked
+type TripTicketing string
+
+// RoundTripRequest contains attributes of a round trip or an open-jaw trip composition
+type RoundTripRequest struct {
+	// Query is a template for every search. Its QueryLegs are ignored
+	Query CreateRequestQuery
+	// Origin of the outbound leg
+	Origin PlaceID
+	// Destination of the outbound leg
+	Destination PlaceID
+	// InboundOrigin is set for open-jaw trips. Default is Destination
+	InboundOrigin *PlaceID
+	// InboundDestination is set for open-jaw trips. Default is Origin
+	InboundDestination *PlaceID
+	// OutboundDate and InboundDate are dates of the legs. Time fields are ignored
+	OutboundDate LocalDatetime
+	InboundDate  LocalDatetime
+	// MinStay is the minimal time between the outbound arrival and the inbound departure
+	MinStay time.Duration
+	// Airports resolve time zones of the airports, so stays between time zones are exact. Default is DefaultAirports().
+	// Local times are compared as they are if a time zone is unknown
+	Airports AirportTable
+	// AllowAirportChange allows the inbound leg of a round trip to depart from
+	// another airport than the outbound leg arrives to. It is always allowed for open-jaw trips
+	AllowAirportChange bool
+	// Candidates is a number of the cheapest one way itineraries per direction to combine. Default is 50
+	Candidates int
+	// MaxOptions limits the number of returned options. All options are returned if zero
+	MaxOptions int
+}
+
+// RoundTripComposition contains trip options sorted by price, from the cheapest
+type RoundTripComposition struct {
+	Options []TripOption `json:"options"`
+	// Savings is the cheapest native option price minus the cheapest separate tickets option price.
+	// Positive savings mean separate tickets are cheaper
+	Savings float64 `json:"savings"`
+	// Errors of the searches keyed by the direction: "outbound", "inbound" or "native"
+	Errors map[string]*ErrorResponse `json:"errors,omitempty"`
+}
+
+// TripOption is a priced trip made of one or more itineraries
+type TripOption struct {
+	Ticketing TripTicketing `json:"ticketing"`
+	// Parts contain a single native itinerary or outbound and inbound one way itineraries
+	Parts []TripPart `json:"parts"`
+	// Price is the sum of the parts prices in major currency units
+	Price float64 `json:"price"`
+	// Stay is the time between the outbound arrival and the inbound departure.
+	// It is set for separate tickets options only
+	Stay time.Duration `json:"-"`
+	// StayInMinutes is Stay in whole minutes
+	StayInMinutes int32 `json:"stayInMinutes,omitempty"`
+}
+
+// TripPart references an itinerary of a search
+type TripPart struct {
+	SessionToken  string         `json:"sessionToken"`
+	ItineraryID   string         `json:"itineraryId"`
+	PricingOption *PricingOption `json:"pricingOption"`
+	Price         float64        `json:"price"`
+	// Departure of the first leg and Arrival of the last leg
+	Departure LocalDatetime `json:"departure"`
+	Arrival   LocalDatetime `json:"arrival"`
+	// DepartureFrom and ArrivalTo are place IDs of the first leg origin and the last leg destination
+	DepartureFrom string `json:"departureFrom"`
+	ArrivalTo     string `json:"arrivalTo"`
+
+	// departureLoc and arrivalLoc are the airport time zones, nil if unknown
+	departureLoc *time.Location
+	arrivalLoc   *time.Location
+}
+
+// ComposeRoundTrip runs one way searches for both directions and the native two leg search concurrently.
+// It combines the cheapest one way itineraries into separate tickets options,
+// drops combinations violating the minimal stay or the airports connection,
+// and returns them along with the native options
+func ComposeRoundTrip(ctx context.Context, c Client, req *RoundTripRequest) (*RoundTripComposition, *ErrorResponse) {
+	inboundOrigin, inboundDestination := req.Destination, req.Origin
+	openJaw := false
+	if req.InboundOrigin != nil {
+		inboundOrigin = *req.InboundOrigin
+		openJaw = true
+	}
+	if req.InboundDestination != nil {
+		inboundDestination = *req.InboundDestination
+		openJaw = true
+	}
+
+	outboundLeg := newQueryLeg(req.Origin, req.Destination, req.OutboundDate)
+	inboundLeg := newQueryLeg(inboundOrigin, inboundDestination, req.InboundDate)
+	directions := []struct {
+		name string
+		legs []*QueryLeg
+	}{
+		{"outbound", []*QueryLeg{outboundLeg}},
+		{"inbound", []*QueryLeg{inboundLeg}},
+		{"native", []*QueryLeg{outboundLeg, inboundLeg}},
+	}
+
+	responses := make([]*CreatePollResponse, len(directions))
+	errs := make([]*ErrorResponse, len(directions))
+	runWorkers(ctx, len(directions), len(directions), func(i int) {
+		query := req.Query
+		query.QueryLegs = directions[i].legs
+		responses[i], errs[i] = Search(ctx, c, &CreateRequest{Query: &query})
+	})
+
+	comp := &RoundTripComposition{Options: make([]TripOption, 0)}
+	for i, d := range directions {
+		if errs[i] == nil && responses[i] == nil {
+			errs[i] = internalErrorResponse("search was not started: " + ctx.Err().Error())
+		}
+		if errs[i] != nil {
+			if comp.Errors == nil {
+				comp.Errors = make(map[string]*ErrorResponse)
+			}
+			comp.Errors[d.name] = errs[i]
+		}
+	}
+	if len(comp.Errors) == len(directions) {
+		return nil, errs[0]
+	}
+
+	candidates := req.Candidates
+	if candidates == 0 {
+		candidates = defaultComposeCandidates
+	}
+
+	airports := req.Airports
+	if airports == nil {
+		airports = DefaultAirports()
+	}
+	zones := newAirportZones(airports)
+
+	if errs[0] == nil && errs[1] == nil {
+		outbound := cheapestParts(responses[0], candidates, zones)
+		inbound := cheapestParts(responses[1], candidates, zones)
+		for _, out := range outbound {
+			for _, in := range inbound {
+				stay := tripStay(out, in)
+				if stay < req.MinStay || stay < 0 {
+					continue
+				}
+				if !openJaw && !req.AllowAirportChange && out.ArrivalTo != in.DepartureFrom {
+					continue
+				}
+
+				comp.Options = append(comp.Options, TripOption{
+					Ticketing:     TripTicketingSeparate,
+					Parts:         []TripPart{out, in},
+					Price:         out.Price + in.Price,
+					Stay:          stay,
+					StayInMinutes: minutes(stay),
+				})
+			}
+		}
+	}
+
+	if errs[2] == nil {
+		for _, p := range cheapestParts(responses[2], candidates, zones) {
+			comp.Options = append(comp.Options, TripOption{
+				Ticketing: TripTicketingSingle,
+				Parts:     []TripPart{p},
+				Price:     p.Price,
+			})
+		}
+	}
+
+	sort.SliceStable(comp.Options, func(i, j int) bool {
+		return comp.Options[i].Price < comp.Options[j].Price
+	})
+
+	var cheapestSeparate, cheapestNative *TripOption
+	for i := range comp.Options {
+		o := &comp.Options[i]
+		if o.Ticketing == TripTicketingSeparate && cheapestSeparate == nil {
+			cheapestSeparate = o
+		}
+		if o.Ticketing == TripTicketingSingle && cheapestNative == nil {
+			cheapestNative = o
+		}
+	}
+	if cheapestSeparate != nil && cheapestNative != nil {
+		comp.Savings = cheapestNative.Price - cheapestSeparate.Price
+	}
+
+	if req.MaxOptions > 0 && len(comp.Options) > req.MaxOptions {
+		comp.Options = comp.Options[:req.MaxOptions]
+	}
+
+	return comp, nil
+}
+
+// cheapestParts returns up to n cheapest priced itineraries of the search
+func cheapestParts(resp *CreatePollResponse, n int, zones *airportZones) []TripPart {
+	if resp.Content == nil || resp.Content.Results == nil {
+		return nil
+	}
+	r := resp.Content.Results
+
+	parts := make([]TripPart, 0, len(r.Itineraries))
+	for id, it := range r.Itineraries {
+		po, price := it.CheapestPricingOption()
+		legs := r.ItineraryLegs(it)
+		if po == nil || len(legs) == 0 {
+			continue
+		}
+
+		parts = append(parts, TripPart{
+			SessionToken:  resp.SessionToken,
+			ItineraryID:   id,
+			PricingOption: po,
+			Price:         price,
+			Departure:     legs[0].DepartureDateTime,
+			Arrival:       legs[len(legs)-1].ArrivalDateTime,
+			DepartureFrom: legs[0].OriginPlaceID,
+			ArrivalTo:     legs[len(legs)-1].DestinationPlaceID,
+			departureLoc:  zones.location(r.Places[legs[0].OriginPlaceID]),
+			arrivalLoc:    zones.location(r.Places[legs[len(legs)-1].DestinationPlaceID]),
+		})
+	}
+
+	sort.Slice(parts, func(i, j int) bool {
+		if parts[i].Price != parts[j].Price {
+			return parts[i].Price < parts[j].Price
+		}
+
+		return parts[i].ItineraryID < parts[j].ItineraryID
+	})
+	if len(parts) > n {
+		parts = parts[:n]
+	}
+
+	return parts
+}
+
+// tripStay returns the time between the outbound arrival and the inbound departure.
+// The local times are resolved in the airport time zones or compared as they are if a time zone is unknown
+func tripStay(out, in TripPart) time.Duration {
+	if out.arrivalLoc == nil || in.departureLoc == nil {
+		return in.Departure.Time().Sub(out.Arrival.Time())
+	}
+
+	return inLocation(in.Departure, in.departureLoc).Sub(inLocation(out.Arrival, out.arrivalLoc))
+}
+
+func inLocation(d LocalDatetime, loc *time.Location) time.Time {
+	return time.Date(int(d.Year), time.Month(d.Month), int(d.Day), int(d.Hour), int(d.Minute), int(d.Second), 0, loc)
+}
+
+// minutes converts a duration to whole minutes for JSON output
+func minutes(d time.Duration) int32 {
+	return int32(d / time.Minute)
+}
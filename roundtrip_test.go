@@ -0,0 +1,123 @@
+package skyscanner
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+// legsClient answers every one way search with a single itinerary of the leg by its origin
+type legsClient struct {
+	Client
+	legs map[string]FlightLeg
+}
+
+func (c legsClient) Create(_ context.Context, req *CreateRequest) (*CreatePollResponse, *ErrorResponse) {
+	r := &Results{
+		Itineraries: map[string]ItineraryResult{},
+		Legs:        map[string]FlightLeg{},
+		Places: map[string]Place{
+			"lhr": {IATA: "LHR"},
+			"jfk": {IATA: "JFK"},
+			"lax": {IATA: "LAX"},
+			"xxx": {IATA: "XXX"},
+		},
+	}
+	if legs := req.Query.QueryLegs; len(legs) == 1 {
+		leg := c.legs[legs[0].OriginPlaceId.IATA]
+		r.Legs["leg"] = leg
+		r.Itineraries["it"] = ItineraryResult{
+			LegIds:         []string{"leg"},
+			PricingOptions: []PricingOption{{Price: Price{Amount: "100000", Unit: PriceUnitMilli}}},
+		}
+	}
+
+	return &CreatePollResponse{Status: ResponseStatusComplete, Content: &Content{Results: r}}, nil
+}
+
+func TestComposeRoundTripStayAcrossTimeZones(t *testing.T) {
+	at := func(day, hour int32) LocalDatetime {
+		return LocalDatetime{Year: 2024, Month: 10, Day: day, Hour: hour}
+	}
+
+	tests := []struct {
+		name     string
+		arriveTo string
+		// departFrom is the inbound origin. Default is LAX
+		departFrom string
+		departAt   LocalDatetime
+		minStay    time.Duration
+		wantStay   time.Duration
+		wantNone   bool
+	}{
+		{
+			// 10:00 in New York is 14:00 UTC, 12:00 in Los Angeles is 19:00 UTC
+			name:     "open jaw between time zones",
+			arriveTo: "jfk",
+			departAt: at(10, 12),
+			minStay:  3 * time.Hour,
+			wantStay: 5 * time.Hour,
+		},
+		{
+			// 10:00 in Los Angeles is 17:00 UTC, 12:00 in New York is 16:00 UTC
+			name:       "departure before the arrival in UTC",
+			arriveTo:   "lax",
+			departFrom: "JFK",
+			departAt:   at(10, 12),
+			wantNone:   true,
+		},
+		{
+			name:     "stay shorter than the minimum",
+			arriveTo: "jfk",
+			departAt: at(10, 12),
+			minStay:  6 * time.Hour,
+			wantNone: true,
+		},
+		{
+			name:     "unknown time zone",
+			arriveTo: "xxx",
+			departAt: at(10, 12),
+			wantStay: 2 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			departFrom := tt.departFrom
+			if departFrom == "" {
+				departFrom = "LAX"
+			}
+			c := legsClient{legs: map[string]FlightLeg{
+				"LHR":      {OriginPlaceID: "lhr", DestinationPlaceID: tt.arriveTo, DepartureDateTime: at(10, 7), ArrivalDateTime: at(10, 10)},
+				departFrom: {OriginPlaceID: strings.ToLower(departFrom), DestinationPlaceID: "lhr", DepartureDateTime: tt.departAt, ArrivalDateTime: at(11, 6)},
+			}}
+			inboundOrigin := PlaceID{IATA: departFrom}
+
+			comp, errResp := ComposeRoundTrip(context.Background(), c, &RoundTripRequest{
+				Origin:        PlaceID{IATA: "LHR"},
+				Destination:   PlaceID{IATA: "JFK"},
+				InboundOrigin: &inboundOrigin,
+				OutboundDate:  at(10, 0),
+				InboundDate:   at(10, 0),
+				MinStay:       tt.minStay,
+			})
+			if errResp != nil {
+				t.Fatal(errResp)
+			}
+
+			if tt.wantNone {
+				if len(comp.Options) != 0 {
+					t.Errorf("got options %+v, want none", comp.Options)
+				}
+				return
+			}
+			if len(comp.Options) != 1 {
+				t.Fatalf("got %d options, want 1", len(comp.Options))
+			}
+			if o := comp.Options[0]; o.Stay != tt.wantStay || o.StayInMinutes != minutes(tt.wantStay) {
+				t.Errorf("stay is %s (%d minutes), want %s", o.Stay, o.StayInMinutes, tt.wantStay)
+			}
+		})
+	}
+}
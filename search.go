@@ -0,0 +1,139 @@
+package skyscanner
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// SearchSettings configure polling, events, tracing and metrics of Search
+type SearchSettings struct {
+	// PollInterval is a delay between polls of an incomplete search. Default is 1 second
+	PollInterval time.Duration
+	// SearchHook receives lifecycle events of the search. Events are not emitted if nil
+	SearchHook SearchHook
+	// Tracer starts a span per search. Spans are not recorded if nil
+	Tracer Tracer
+	// Metrics records search metrics. Metrics are not recorded if nil
+	Metrics Metrics
+}
+
+// SearchConfigurer provides the settings Search uses with the client.
+// Clients created by NewClient implement it with the Config values. Client wrappers should implement it
+// to keep the settings of the wrapped client, Search uses the default settings for clients without it
+type SearchConfigurer interface {
+	SearchSettings() SearchSettings
+}
+
+// Search does a create request and polls the search until it is complete.
+// If the search is not completed because of an error, the last received response is returned along with the error.
+// The settings are taken from the client if it implements SearchConfigurer
+func Search(ctx context.Context, c Client, req *CreateRequest) (*CreatePollResponse, *ErrorResponse) {
+	return SearchWithProgress(ctx, c, req, nil)
+}
+
+// SearchWithProgress is Search calling onProgress with the accumulated response after the create request and every poll
+func SearchWithProgress(ctx context.Context, c Client, req *CreateRequest, onProgress func(*CreatePollResponse)) (*CreatePollResponse, *ErrorResponse) {
+	o := searchSettings(c)
+	ctx, span := o.Tracer.Start(ctx, "skyscanner.Search")
+	defer span.End()
+	progress := newSearchProgress()
+
+	fail := func(resp *CreatePollResponse, errResp *ErrorResponse, event SearchEventType, errorClass string) (*CreatePollResponse, *ErrorResponse) {
+		status := ResponseStatusUnspecified
+		if resp != nil {
+			status = resp.Status
+			o.emit(ctx, SearchEvent{Type: event, SessionToken: resp.SessionToken, Error: errResp})
+		} else {
+			o.emit(ctx, SearchEvent{Type: event, Error: errResp})
+		}
+
+		span.RecordError(errResp)
+		span.SetAttributes(Attribute{"skyscanner.search.polls", progress.polls})
+		o.Metrics.RecordSearch(ctx, progress.metric(status, errorClass))
+
+		return resp, errResp
+	}
+
+	resp, errResp := c.Create(ctx, req)
+	if errResp != nil {
+		return fail(nil, errResp, SearchEventFailed, searchErrorClass(ctx, errResp))
+	}
+	progress.received(resp)
+	o.emitResponse(ctx, SearchEventCreated, resp)
+	if onProgress != nil {
+		onProgress(resp)
+	}
+
+	for resp.Status == ResponseStatusIncomplete {
+		timer := time.NewTimer(o.PollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			errResp := internalErrorResponse("search polling error: " + ctx.Err().Error())
+			errResp.Err = ctx.Err()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				errResp.Code = http.StatusGatewayTimeout
+				errResp.Err = &TimeoutError{Endpoint: EndpointPoll, Phase: TimeoutPhaseContext, Err: ctx.Err()}
+			}
+			return fail(resp, errResp, SearchEventFailed, searchErrorClass(ctx, errResp))
+		case <-timer.C:
+		}
+
+		progress.polls++
+		poll, errResp := c.Poll(ctx, &PollRequest{SessionToken: resp.SessionToken})
+		if errResp != nil {
+			if isSessionExpired(errResp) {
+				return fail(resp, errResp, SearchEventSessionExpired, ErrorClassClient)
+			}
+			return fail(resp, errResp, SearchEventFailed, searchErrorClass(ctx, errResp))
+		}
+
+		resp = mergePollResponse(resp, poll)
+		progress.received(resp)
+		o.emitResponse(ctx, SearchEventPollReceived, resp)
+		if onProgress != nil {
+			onProgress(resp)
+		}
+	}
+
+	if resp.Status == ResponseStatusFailed {
+		return fail(resp, internalErrorResponse("search failed"), SearchEventFailed, ErrorClassSearch)
+	}
+
+	if o.hooked() {
+		o.emit(ctx, SearchEvent{
+			Type:         SearchEventCompleted,
+			SessionToken: resp.SessionToken,
+			Stats:        responseStats(resp, responseResults(resp)),
+		})
+	}
+	span.SetAttributes(
+		Attribute{"skyscanner.search.polls", progress.polls},
+		Attribute{"skyscanner.search.itineraries", len(responseResults(resp).Itineraries)},
+	)
+	o.Metrics.RecordSearch(ctx, progress.metric(resp.Status, ""))
+
+	return resp, nil
+}
+
+// searchSettings returns the settings of the client with defaults
+func searchSettings(c Client) SearchSettings {
+	var s SearchSettings
+	if sc, ok := c.(SearchConfigurer); ok {
+		s = sc.SearchSettings()
+	}
+
+	if s.PollInterval == 0 {
+		s.PollInterval = time.Second
+	}
+	if s.Tracer == nil {
+		s.Tracer = noopTracer{}
+	}
+	if s.Metrics == nil {
+		s.Metrics = noopMetrics{}
+	}
+
+	return s
+}
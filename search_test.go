@@ -0,0 +1,83 @@
+package skyscanner
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// stubClient completes a search after the given number of polls
+type stubClient struct {
+	Client
+	polls int
+}
+
+func (c *stubClient) Create(context.Context, *CreateRequest) (*CreatePollResponse, *ErrorResponse) {
+	return &CreatePollResponse{SessionToken: "token", Status: ResponseStatusIncomplete}, nil
+}
+
+func (c *stubClient) Poll(context.Context, *PollRequest) (*CreatePollResponse, *ErrorResponse) {
+	c.polls--
+	if c.polls > 0 {
+		return &CreatePollResponse{Status: ResponseStatusIncomplete}, nil
+	}
+
+	return &CreatePollResponse{Status: ResponseStatusComplete}, nil
+}
+
+// configuredClient wraps a client and provides its search settings
+type configuredClient struct {
+	Client
+	settings SearchSettings
+}
+
+func (c configuredClient) SearchSettings() SearchSettings {
+	return c.settings
+}
+
+func TestSearchUsesClientSettings(t *testing.T) {
+	var events []SearchEventType
+	hook := SearchHookFunc(func(_ context.Context, e SearchEvent) {
+		events = append(events, e.Type)
+	})
+	c := configuredClient{
+		Client:   &stubClient{polls: 2},
+		settings: SearchSettings{PollInterval: time.Millisecond, SearchHook: hook},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	resp, errResp := Search(ctx, c, &CreateRequest{})
+	if errResp != nil {
+		t.Fatal(errResp)
+	}
+	if resp.Status != ResponseStatusComplete || resp.SessionToken != "token" {
+		t.Errorf("unexpected response %+v", resp)
+	}
+
+	want := []SearchEventType{SearchEventCreated, SearchEventPollReceived, SearchEventPollReceived, SearchEventCompleted}
+	if len(events) != len(want) {
+		t.Fatalf("got events %v, want %v", events, want)
+	}
+	for i := range want {
+		if events[i] != want[i] {
+			t.Errorf("event %d is %s, want %s", i, events[i], want[i])
+		}
+	}
+}
+
+func TestNewClientSearchSettings(t *testing.T) {
+	hook := SearchHookFunc(func(context.Context, SearchEvent) {})
+	c := NewClient(&Config{APIKey: "key", PollInterval: time.Minute, SearchHook: hook})
+
+	sc, ok := c.(SearchConfigurer)
+	if !ok {
+		t.Fatal("client does not implement SearchConfigurer")
+	}
+	if s := sc.SearchSettings(); s.PollInterval != time.Minute || s.SearchHook == nil {
+		t.Errorf("unexpected settings %+v", s)
+	}
+	if s := searchSettings(&stubClient{}); s.PollInterval != time.Second || s.Tracer == nil || s.Metrics == nil {
+		t.Errorf("unexpected default settings %+v", s)
+	}
+}
@@ -1,5 +1,10 @@
 package skyscanner
 
+import (
+	"sort"
+	"time"
+)
+
 // SortingOptions contains data for sorting by best, cheapest or fastest criteria
 type SortingOptions struct {
 	Best     []SortingOptionItem `json:"best"`
@@ -12,3 +17,226 @@ type SortingOptionItem struct {
 	Score       float32 `json:"score"`
 	ItineraryID string  `json:"itineraryId"`
 }
+
+// ItineraryLess reports whether the itinerary a should be placed before b
+type ItineraryLess func(r *Results, a, b ItineraryResult) bool
+
+// Scorer scores a set of itineraries. Higher score is better
+type Scorer interface {
+	Score(r *Results, ids []string) []SortingOptionItem
+}
+
+// ScorerFunc is an adapter to use ordinary functions as Scorer
+type ScorerFunc func(r *Results, ids []string) []SortingOptionItem
+
+// Score calls f(r, ids)
+func (f ScorerFunc) Score(r *Results, ids []string) []SortingOptionItem {
+	return f(r, ids)
+}
+
+// WeightedScorer blends several criteria into a single score.
+// Every criterion is normalized to the [0, 1] range within the scored set,
+// where 1 is the best value, and multiplied by its weight.
+// The result is divided by the weights sum, so scores are in the [0, 1] range as well
+type WeightedScorer struct {
+	Price       float64 // cheapest pricing option price, lower is better
+	Duration    float64 // total legs duration, lower is better
+	Stops       float64 // max legs stop count, lower is better
+	AgentRating float64 // average rating of the cheapest option agents, higher is better
+	Eco         float64 // EcoContenderDelta, higher is better
+}
+
+// DefaultWeightedScorer is a scorer balancing price and convenience
+var DefaultWeightedScorer = WeightedScorer{
+	Price:       0.5,
+	Duration:    0.25,
+	Stops:       0.15,
+	AgentRating: 0.05,
+	Eco:         0.05,
+}
+
+// SortItineraries sorts the itinerary IDs in place. Ties are broken by the ID
+func (r *Results) SortItineraries(ids []string, less ItineraryLess) {
+	sort.SliceStable(ids, func(i, j int) bool {
+		a, b := r.Itineraries[ids[i]], r.Itineraries[ids[j]]
+		if less(r, a, b) {
+			return true
+		}
+		if less(r, b, a) {
+			return false
+		}
+
+		return ids[i] < ids[j]
+	})
+}
+
+// Rank scores the itineraries and returns them sorted from the best to the worst
+func (r *Results) Rank(ids []string, s Scorer) []SortingOptionItem {
+	items := s.Score(r, ids)
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Score != items[j].Score {
+			return items[i].Score > items[j].Score
+		}
+
+		return items[i].ItineraryID < items[j].ItineraryID
+	})
+
+	return items
+}
+
+// SortCheapest orders itineraries by the cheapest pricing option. Itineraries without price go last
+func SortCheapest(r *Results, a, b ItineraryResult) bool {
+	pa, priceA := a.CheapestPricingOption()
+	pb, priceB := b.CheapestPricingOption()
+	if pa == nil || pb == nil {
+		return pa != nil && pb == nil
+	}
+
+	return priceA < priceB
+}
+
+// SortFastest orders itineraries by the sum of legs durations
+func SortFastest(r *Results, a, b ItineraryResult) bool {
+	return r.ItineraryDuration(a) < r.ItineraryDuration(b)
+}
+
+// SortEarliestDeparture orders itineraries by the first leg departure, earliest first
+func SortEarliestDeparture(r *Results, a, b ItineraryResult) bool {
+	return departure(r, a).Before(departure(r, b))
+}
+
+// SortLatestDeparture orders itineraries by the first leg departure, latest first
+func SortLatestDeparture(r *Results, a, b ItineraryResult) bool {
+	return departure(r, a).After(departure(r, b))
+}
+
+// SortFewestStops orders itineraries by the max legs stop count
+func SortFewestStops(r *Results, a, b ItineraryResult) bool {
+	return r.ItineraryStops(a) < r.ItineraryStops(b)
+}
+
+// SortGreenest orders eco contenders first, then by EcoContenderDelta, highest first
+func SortGreenest(r *Results, a, b ItineraryResult) bool {
+	sa, sb := a.SustainabilityData, b.SustainabilityData
+	if sa.IsEcoContender != sb.IsEcoContender {
+		return sa.IsEcoContender
+	}
+
+	return sa.EcoContenderDelta > sb.EcoContenderDelta
+}
+
+// Score implements Scorer
+func (w WeightedScorer) Score(r *Results, ids []string) []SortingOptionItem {
+	const criteria = 5
+	weights := [criteria]float64{w.Price, w.Duration, w.Stops, w.AgentRating, w.Eco}
+	lowerIsBetter := [criteria]bool{true, true, true, false, false}
+
+	var total float64
+	for _, weight := range weights {
+		total += weight
+	}
+
+	values := make([][criteria]float64, len(ids))
+	known := make([][criteria]bool, len(ids))
+	for i, id := range ids {
+		it := r.Itineraries[id]
+		po, price := it.CheapestPricingOption()
+		values[i] = [criteria]float64{
+			price,
+			float64(r.ItineraryDuration(it)),
+			float64(r.ItineraryStops(it)),
+			0,
+			float64(it.SustainabilityData.EcoContenderDelta),
+		}
+		known[i] = [criteria]bool{po != nil, true, true, false, true}
+		if po != nil {
+			values[i][3], known[i][3] = agentsRating(r, po.AgentIds)
+		}
+	}
+
+	var lo, hi [criteria]float64
+	for c := 0; c < criteria; c++ {
+		first := true
+		for i := range values {
+			if !known[i][c] {
+				continue
+			}
+			if first || values[i][c] < lo[c] {
+				lo[c] = values[i][c]
+			}
+			if first || values[i][c] > hi[c] {
+				hi[c] = values[i][c]
+			}
+			first = false
+		}
+	}
+
+	items := make([]SortingOptionItem, len(ids))
+	for i, id := range ids {
+		var score float64
+		for c := 0; c < criteria; c++ {
+			if !known[i][c] || weights[c] == 0 {
+				continue
+			}
+
+			norm := 1.0
+			if hi[c] > lo[c] {
+				norm = (values[i][c] - lo[c]) / (hi[c] - lo[c])
+				if lowerIsBetter[c] {
+					norm = 1 - norm
+				}
+			}
+			score += norm * weights[c]
+		}
+		if total > 0 {
+			score /= total
+		}
+
+		items[i] = SortingOptionItem{Score: float32(score), ItineraryID: id}
+	}
+
+	return items
+}
+
+func departure(r *Results, it ItineraryResult) time.Time {
+	legs := r.ItineraryLegs(it)
+	if len(legs) == 0 {
+		return time.Time{}
+	}
+
+	return legs[0].DepartureDateTime.Time()
+}
+
+// agentsRating returns the average rating of the known agents
+func agentsRating(r *Results, agentIDs []string) (float64, bool) {
+	var (
+		sum   float64
+		count int
+	)
+	for _, id := range agentIDs {
+		if a, ok := r.Agents[id]; ok {
+			sum += float64(a.Rating)
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, false
+	}
+
+	return sum / float64(count), true
+}
+
+// ComputeSortingOptions ranks all the itineraries locally.
+// Best uses DefaultWeightedScorer, Cheapest and Fastest use price and duration scores only
+func (r *Results) ComputeSortingOptions() *SortingOptions {
+	ids := make([]string, 0, len(r.Itineraries))
+	for id := range r.Itineraries {
+		ids = append(ids, id)
+	}
+
+	return &SortingOptions{
+		Best:     r.Rank(ids, DefaultWeightedScorer),
+		Cheapest: r.Rank(ids, WeightedScorer{Price: 1}),
+		Fastest:  r.Rank(ids, WeightedScorer{Duration: 1}),
+	}
+}
@@ -0,0 +1,112 @@
+package skyscanner
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSortItineraries(t *testing.T) {
+	tests := []struct {
+		name string
+		less ItineraryLess
+		want []string
+	}{
+		{name: "cheapest with unpriced last", less: SortCheapest, want: []string{"change", "onestop", "direct", "unpriced"}},
+		{name: "fastest with ties by ID", less: SortFastest, want: []string{"direct", "unpriced", "change", "onestop"}},
+		{name: "earliest departure", less: SortEarliestDeparture, want: []string{"change", "direct", "unpriced", "onestop"}},
+		{name: "latest departure", less: SortLatestDeparture, want: []string{"onestop", "direct", "unpriced", "change"}},
+		{name: "fewest stops", less: SortFewestStops, want: []string{"direct", "unpriced", "change", "onestop"}},
+		{name: "greenest", less: SortGreenest, want: []string{"onestop", "change", "direct", "unpriced"}},
+	}
+
+	r := testResults()
+	r.Itineraries["unpriced"] = ItineraryResult{LegIds: []string{"leg-direct"}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := []string{"unpriced", "onestop", "direct", "change"}
+			r.SortItineraries(ids, tt.less)
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("got %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeightedScorerRank(t *testing.T) {
+	all := []string{"change", "direct", "onestop"}
+
+	tests := []struct {
+		name   string
+		scorer WeightedScorer
+		ids    []string
+		want   []SortingOptionItem
+	}{
+		{
+			name:   "price",
+			scorer: WeightedScorer{Price: 1},
+			ids:    all,
+			want:   []SortingOptionItem{{1, "change"}, {2.0 / 3, "onestop"}, {0, "direct"}},
+		},
+		{
+			name:   "unknown price scores zero",
+			scorer: WeightedScorer{Price: 1},
+			ids:    []string{"unpriced", "onestop", "direct"},
+			want:   []SortingOptionItem{{1, "onestop"}, {0, "direct"}, {0, "unpriced"}},
+		},
+		{
+			name:   "duration",
+			scorer: WeightedScorer{Duration: 1},
+			ids:    all,
+			want:   []SortingOptionItem{{1, "direct"}, {1.0 / 3, "change"}, {0, "onestop"}},
+		},
+		{
+			name:   "agent rating of unknown agents",
+			scorer: WeightedScorer{AgentRating: 1},
+			ids:    all,
+			want:   []SortingOptionItem{{1, "direct"}, {0, "change"}, {0, "onestop"}},
+		},
+		{
+			name:   "eco",
+			scorer: WeightedScorer{Eco: 2},
+			ids:    all,
+			want:   []SortingOptionItem{{1, "onestop"}, {0, "change"}, {0, "direct"}},
+		},
+		{
+			name:   "weights are normalized",
+			scorer: WeightedScorer{Price: 3, Duration: 3},
+			ids:    all,
+			want:   []SortingOptionItem{{2.0 / 3, "change"}, {0.5, "direct"}, {1.0 / 3, "onestop"}},
+		},
+		{
+			name:   "single itinerary",
+			scorer: DefaultWeightedScorer,
+			ids:    []string{"onestop"},
+			want:   []SortingOptionItem{{1, "onestop"}},
+		},
+		{
+			name: "zero weights",
+			ids:  all,
+			want: []SortingOptionItem{{0, "change"}, {0, "direct"}, {0, "onestop"}},
+		},
+	}
+
+	r := testResults()
+	r.Itineraries["unpriced"] = ItineraryResult{LegIds: []string{"leg-direct"}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := r.Rank(tt.ids, tt.scorer)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].ItineraryID != tt.want[i].ItineraryID || math.Abs(float64(got[i].Score-tt.want[i].Score)) > 1e-6 {
+					t.Errorf("got %+v, want %+v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
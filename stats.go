@@ -36,6 +36,113 @@ type ItineraryStopSummaryStats struct {
 // ItineraryStopTicketStats itinerary stats based on type of ticket
 type ItineraryStopTicketStats struct {
 	SingleTicket      ItinerarySummary `json:"singleTicket"`
-	MultiTicketNonNpt ItinerarySummary `json:"multiTicketNonNpt"`
-	MultiTicketNpt    ItinerarySummary `json:"multiTicketNpt"`
+	MultiTicketNonNpt ItinerarySummary `json:"multiTicketNonNpt"` // Self transfers protected by the agent
+	MultiTicketNpt    ItinerarySummary `json:"multiTicketNpt"`    // Non-protected self transfers
+}
+
+// ComputeStats computes stats for all the itineraries the same way the API does for the whole search
+func (r *Results) ComputeStats() *Stats {
+	ids := make([]string, 0, len(r.Itineraries))
+	for id := range r.Itineraries {
+		ids = append(ids, id)
+	}
+
+	return r.SubsetStats(ids)
+}
+
+// SubsetStats computes stats for the itineraries with the given IDs. Unknown IDs are skipped
+func (r *Results) SubsetStats(ids []string) *Stats {
+	s := &Stats{}
+	its := &s.Itineraries
+
+	counted := 0
+	for _, id := range ids {
+		it, ok := r.Itineraries[id]
+		if !ok {
+			continue
+		}
+
+		d := r.ItineraryDuration(it)
+		if counted == 0 || d < its.MinDuration {
+			its.MinDuration = d
+		}
+		if d > its.MaxDuration {
+			its.MaxDuration = d
+		}
+		counted++
+
+		cheapest, _ := it.CheapestPricingOption()
+		its.Total.add(cheapest)
+
+		var bucket *ItineraryStopSummaryStats
+		switch stops := r.ItineraryStops(it); {
+		case stops == 0:
+			bucket = &its.Stops.Direct
+		case stops == 1:
+			bucket = &its.Stops.OneStop
+		default:
+			bucket = &its.Stops.TwoPlusStops
+		}
+		bucket.Total.add(cheapest)
+		bucket.TicketTypes.add(it)
+
+		if r.ItineraryHasAirportChange(it) {
+			its.HasChangeAirportTransfer = true
+		}
+	}
+
+	return s
+}
+
+// add counts the itinerary in every ticket type bucket it has a pricing option for
+func (s *ItineraryStopTicketStats) add(it ItineraryResult) {
+	var single, nonNpt, npt []PricingOption
+	for _, po := range it.PricingOptions {
+		switch po.TransferType {
+		case TransferTypeSelfTransfer:
+			npt = append(npt, po)
+		case TransferTypeProtectedSelfTransfer:
+			nonNpt = append(nonNpt, po)
+		default:
+			single = append(single, po)
+		}
+	}
+
+	for _, b := range []struct {
+		summary *ItinerarySummary
+		options []PricingOption
+	}{
+		{&s.SingleTicket, single},
+		{&s.MultiTicketNonNpt, nonNpt},
+		{&s.MultiTicketNpt, npt},
+	} {
+		if len(b.options) == 0 {
+			continue
+		}
+
+		cheapest, _ := ItineraryResult{PricingOptions: b.options}.CheapestPricingOption()
+		b.summary.add(cheapest)
+	}
+}
+
+// add counts the itinerary and lowers the minimal price if the pricing option is cheaper
+func (s *ItinerarySummary) add(po *PricingOption) {
+	s.Count++
+	if po == nil {
+		return
+	}
+
+	if s.MinPrice.Amount == "" {
+		s.MinPrice = po.Price
+		return
+	}
+
+	p, err := po.Price.ToFloat()
+	if err != nil {
+		return
+	}
+
+	if current, err := s.MinPrice.ToFloat(); err != nil || p < current {
+		s.MinPrice = po.Price
+	}
 }
@@ -0,0 +1,92 @@
+package skyscanner
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSubsetStats(t *testing.T) {
+	price := func(amount string) Price {
+		return Price{Amount: amount, Unit: PriceUnitMilli}
+	}
+
+	tests := []struct {
+		name string
+		ids  []string
+		want Stats
+	}{
+		{
+			name: "all itineraries",
+			ids:  []string{"direct", "onestop", "change"},
+			want: Stats{Itineraries: ItineraryStats{
+				MinDuration: 420,
+				MaxDuration: 600,
+				Total:       ItinerarySummary{Count: 3, MinPrice: price("200000")},
+				Stops: ItineraryStopStats{
+					Direct: ItineraryStopSummaryStats{
+						Total:       ItinerarySummary{Count: 1, MinPrice: price("500000")},
+						TicketTypes: ItineraryStopTicketStats{SingleTicket: ItinerarySummary{Count: 1, MinPrice: price("500000")}},
+					},
+					OneStop: ItineraryStopSummaryStats{
+						Total: ItinerarySummary{Count: 2, MinPrice: price("200000")},
+						TicketTypes: ItineraryStopTicketStats{
+							// the single ticket option of "change" has no price
+							SingleTicket:      ItinerarySummary{Count: 1},
+							MultiTicketNonNpt: ItinerarySummary{Count: 1, MinPrice: price("200000")},
+							MultiTicketNpt:    ItinerarySummary{Count: 1, MinPrice: price("300000")},
+						},
+					},
+				},
+				HasChangeAirportTransfer: true,
+			}},
+		},
+		{
+			name: "unknown IDs are skipped",
+			ids:  []string{"unknown", "onestop"},
+			want: Stats{Itineraries: ItineraryStats{
+				MinDuration: 600,
+				MaxDuration: 600,
+				Total:       ItinerarySummary{Count: 1, MinPrice: price("300000")},
+				Stops: ItineraryStopStats{OneStop: ItineraryStopSummaryStats{
+					Total:       ItinerarySummary{Count: 1, MinPrice: price("300000")},
+					TicketTypes: ItineraryStopTicketStats{MultiTicketNpt: ItinerarySummary{Count: 1, MinPrice: price("300000")}},
+				}},
+			}},
+		},
+		{
+			name: "two plus stops",
+			ids:  []string{"twostops"},
+			want: Stats{Itineraries: ItineraryStats{
+				MinDuration: 900,
+				MaxDuration: 900,
+				Total:       ItinerarySummary{Count: 1},
+				Stops: ItineraryStopStats{TwoPlusStops: ItineraryStopSummaryStats{
+					Total: ItinerarySummary{Count: 1},
+				}},
+			}},
+		},
+		{name: "empty subset"},
+	}
+
+	r := testResults()
+	r.Legs["leg-twostops"] = FlightLeg{DurationInMinutes: 900, StopCount: 2}
+	r.Itineraries["twostops"] = ItineraryResult{LegIds: []string{"leg-twostops"}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.SubsetStats(tt.ids); !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("got  %+v\nwant %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputeStats(t *testing.T) {
+	r := testResults()
+
+	got := r.ComputeStats()
+	want := r.SubsetStats([]string{"change", "direct", "onestop"})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got  %+v\nwant %+v", got, want)
+	}
+}
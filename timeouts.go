@@ -0,0 +1,301 @@
+package skyscanner
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	EndpointCreate      Endpoint = "create"
+	EndpointPoll        Endpoint = "poll"
+	EndpointCulture     Endpoint = "culture"
+	EndpointAutoSuggest Endpoint = "autosuggest"
+
+	TimeoutPhaseConnect        TimeoutPhase = "connect"
+	TimeoutPhaseTLSHandshake   TimeoutPhase = "tls_handshake"
+	TimeoutPhaseResponseHeader TimeoutPhase = "response_header"
+	TimeoutPhaseBodyRead       TimeoutPhase = "body_read"
+	TimeoutPhaseTotal          TimeoutPhase = "total"
+	// TimeoutPhaseContext is a caller context deadline shorter than the endpoint timeouts
+	TimeoutPhaseContext TimeoutPhase = "context"
+
+	defaultConnectTimeout = 5 * time.Second
+	defaultTLSTimeout     = 5 * time.Second
+)
+
+type Endpoint string
+type TimeoutPhase string
+
+// ErrTimeout matches TimeoutError with errors.Is
+var ErrTimeout = errors.New("skyscanner request timeout")
+
+// TimeoutError is returned when a request exceeds a timeout or the caller context deadline
+type TimeoutError struct {
+	Endpoint Endpoint
+	Phase    TimeoutPhase
+	// Timeout is the exceeded timeout, zero for the caller context deadline
+	Timeout time.Duration
+	// Err is the underlying error, e.g. context.DeadlineExceeded
+	Err error
+}
+
+// Error implements the error interface
+func (e *TimeoutError) Error() string {
+	msg := string(e.Endpoint) + " request " + string(e.Phase) + " timeout"
+	if e.Timeout > 0 {
+		msg += " of " + e.Timeout.String()
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+
+	return msg
+}
+
+// Is reports whether the target is ErrTimeout
+func (e *TimeoutError) Is(target error) bool {
+	return target == ErrTimeout
+}
+
+// Unwrap returns the underlying error
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// Timeouts are timeouts of an endpoint. Zero values are replaced with defaults.
+// The caller context deadline always wins when it is shorter
+type Timeouts struct {
+	// Connect limits establishing a TCP connection. Default is 5 seconds
+	Connect time.Duration
+	// TLSHandshake limits the TLS handshake. Default is 5 seconds
+	TLSHandshake time.Duration
+	// ResponseHeader limits waiting for response headers after the request is written. Zero means no limit
+	ResponseHeader time.Duration
+	// BodyRead limits receiving the response after its first byte arrives. Zero means no limit
+	BodyRead time.Duration
+	// Total limits the whole call including the body read. Default is Config.QueriesTimeout
+	Total time.Duration
+}
+
+// EndpointTimeouts are timeouts per endpoint
+type EndpointTimeouts struct {
+	Create      Timeouts
+	Poll        Timeouts
+	Culture     Timeouts
+	AutoSuggest Timeouts
+}
+
+func (t Timeouts) withDefaults(total time.Duration) Timeouts {
+	if t.Connect <= 0 {
+		t.Connect = defaultConnectTimeout
+	}
+	if t.TLSHandshake <= 0 {
+		t.TLSHandshake = defaultTLSTimeout
+	}
+	if t.Total <= 0 {
+		t.Total = total
+	}
+
+	return t
+}
+
+func (t EndpointTimeouts) withDefaults(total time.Duration) EndpointTimeouts {
+	return EndpointTimeouts{
+		Create:      t.Create.withDefaults(total),
+		Poll:        t.Poll.withDefaults(total),
+		Culture:     t.Culture.withDefaults(total),
+		AutoSuggest: t.AutoSuggest.withDefaults(total),
+	}
+}
+
+func (t EndpointTimeouts) get(e Endpoint) Timeouts {
+	switch e {
+	case EndpointCreate:
+		return t.Create
+	case EndpointPoll:
+		return t.Poll
+	case EndpointAutoSuggest:
+		return t.AutoSuggest
+	}
+
+	return t.Culture
+}
+
+// endpointOf returns the endpoint of the URI
+func endpointOf(uri string) Endpoint {
+	switch {
+	case strings.HasPrefix(uri, pollPathPrefix):
+		return EndpointPoll
+	case strings.HasPrefix(uri, "/flights/live/search/create"):
+		return EndpointCreate
+	case strings.HasPrefix(uri, "/autosuggest/"):
+		return EndpointAutoSuggest
+	}
+
+	return EndpointCulture
+}
+
+// newHTTPClient returns the HTTP client shared by all the endpoints, so connections are reused.
+// Timeouts are applied per request by requestTimeouts
+func newHTTPClient() *http.Client {
+	dialer := &net.Dialer{KeepAlive: 30 * time.Second}
+
+	return &http.Client{Transport: &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		DialContext:         dialer.DialContext,
+		TLSClientConfig:     &tls.Config{MinVersion: tls.VersionTLS12},
+		ForceAttemptHTTP2:   true,
+		MaxIdleConnsPerHost: 10,
+		IdleConnTimeout:     90 * time.Second,
+	}}
+}
+
+// requestTimeouts limits an endpoint request by the total timeout and the connect, TLS handshake,
+// response header and body read timeouts. The phases are tracked with httptrace and
+// an exceeded timeout cancels the request context with a TimeoutError cause
+type requestTimeouts struct {
+	endpoint Endpoint
+	timeouts Timeouts
+	ctx      context.Context
+	cancel   context.CancelCauseFunc
+	// cancelTotal releases the total timeout context
+	cancelTotal context.CancelFunc
+
+	mu     sync.Mutex
+	timers map[TimeoutPhase]*time.Timer
+	// dials is a number of connection attempts in progress, there can be several per address family
+	dials int
+}
+
+func newRequestTimeouts(ctx context.Context, e Endpoint, t Timeouts) *requestTimeouts {
+	r := &requestTimeouts{endpoint: e, timeouts: t, timers: make(map[TimeoutPhase]*time.Timer)}
+	ctx, r.cancelTotal = context.WithTimeoutCause(ctx, t.Total,
+		&TimeoutError{Endpoint: e, Phase: TimeoutPhaseTotal, Timeout: t.Total, Err: context.DeadlineExceeded})
+	ctx, r.cancel = context.WithCancelCause(ctx)
+	r.ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		ConnectStart: func(string, string) {
+			r.mu.Lock()
+			r.dials++
+			r.mu.Unlock()
+			r.start(TimeoutPhaseConnect, t.Connect)
+		},
+		ConnectDone: func(_, _ string, err error) {
+			r.mu.Lock()
+			r.dials--
+			last := r.dials == 0
+			r.mu.Unlock()
+			if err == nil || last {
+				r.stop(TimeoutPhaseConnect)
+			}
+		},
+		TLSHandshakeStart: func() { r.start(TimeoutPhaseTLSHandshake, t.TLSHandshake) },
+		TLSHandshakeDone:  func(tls.ConnectionState, error) { r.stop(TimeoutPhaseTLSHandshake) },
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			// a retried request must not be limited by the body read of the previous response
+			r.stop(TimeoutPhaseBodyRead)
+			r.start(TimeoutPhaseResponseHeader, t.ResponseHeader)
+		},
+		GotFirstResponseByte: func() {
+			r.stop(TimeoutPhaseResponseHeader)
+			r.start(TimeoutPhaseBodyRead, t.BodyRead)
+		},
+	})
+
+	return r
+}
+
+// start starts the timer of the phase unless it is running. Zero timeout means no limit
+func (r *requestTimeouts) start(phase TimeoutPhase, timeout time.Duration) {
+	if timeout <= 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.timers == nil || r.timers[phase] != nil {
+		return
+	}
+	r.timers[phase] = time.AfterFunc(timeout, func() {
+		r.cancel(&TimeoutError{Endpoint: r.endpoint, Phase: phase, Timeout: timeout, Err: context.DeadlineExceeded})
+	})
+}
+
+func (r *requestTimeouts) stop(phase TimeoutPhase) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if t := r.timers[phase]; t != nil {
+		t.Stop()
+		delete(r.timers, phase)
+	}
+}
+
+// release stops the timers and cancels the request context
+func (r *requestTimeouts) release() {
+	r.mu.Lock()
+	for _, t := range r.timers {
+		t.Stop()
+	}
+	r.timers = nil
+	r.mu.Unlock()
+
+	r.cancel(nil)
+	r.cancelTotal()
+}
+
+// timeoutError returns the typed timeout error of the failed request or nil if it did not time out.
+// callerCtx is the caller context, reqCtx is the request context canceled by the exceeded timeout
+func timeoutError(callerCtx, reqCtx context.Context, e Endpoint, err error) error {
+	if errors.Is(err, ErrTimeout) {
+		return err
+	}
+	if errors.Is(callerCtx.Err(), context.DeadlineExceeded) {
+		return &TimeoutError{Endpoint: e, Phase: TimeoutPhaseContext, Err: err}
+	}
+	if callerCtx.Err() != nil {
+		return nil
+	}
+
+	var tErr *TimeoutError
+	if errors.As(context.Cause(reqCtx), &tErr) {
+		return tErr
+	}
+
+	return nil
+}
+
+// timeoutBody converts errors of reads canceled by a timeout to TimeoutError
+// and releases the request timeouts when closed
+type timeoutBody struct {
+	io.ReadCloser
+	callerCtx context.Context
+	timeouts  *requestTimeouts
+}
+
+func (b *timeoutBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err == nil || err == io.EOF {
+		return n, err
+	}
+	if tErr := timeoutError(b.callerCtx, b.timeouts.ctx, b.timeouts.endpoint, err); tErr != nil {
+		return n, tErr
+	}
+
+	return n, err
+}
+
+func (b *timeoutBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.timeouts.release()
+
+	return err
+}
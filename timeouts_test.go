@@ -0,0 +1,169 @@
+package skyscanner
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testTimeout = 50 * time.Millisecond
+
+func TestTimeoutPhases(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  func(w http.ResponseWriter, release <-chan struct{})
+		tls      bool
+		timeouts Timeouts
+		deadline time.Duration
+		want     TimeoutPhase
+	}{
+		{
+			name:     "tls handshake",
+			tls:      true,
+			timeouts: Timeouts{TLSHandshake: testTimeout},
+			want:     TimeoutPhaseTLSHandshake,
+		},
+		{
+			name:     "response header",
+			handler:  func(_ http.ResponseWriter, release <-chan struct{}) { <-release },
+			timeouts: Timeouts{ResponseHeader: testTimeout},
+			want:     TimeoutPhaseResponseHeader,
+		},
+		{
+			name: "body read",
+			handler: func(w http.ResponseWriter, release <-chan struct{}) {
+				_, _ = w.Write([]byte(`{"locales":[`))
+				w.(http.Flusher).Flush()
+				<-release
+			},
+			timeouts: Timeouts{ResponseHeader: time.Hour, BodyRead: testTimeout},
+			want:     TimeoutPhaseBodyRead,
+		},
+		{
+			name:     "total",
+			handler:  func(_ http.ResponseWriter, release <-chan struct{}) { <-release },
+			timeouts: Timeouts{Total: testTimeout},
+			want:     TimeoutPhaseTotal,
+		},
+		{
+			name:     "caller context",
+			handler:  func(_ http.ResponseWriter, release <-chan struct{}) { <-release },
+			timeouts: Timeouts{ResponseHeader: time.Hour},
+			deadline: testTimeout,
+			want:     TimeoutPhaseContext,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			release := make(chan struct{})
+			var serverURL string
+			if tt.tls {
+				// the listener accepts connections and never answers the TLS handshake
+				lis, err := net.Listen("tcp", "127.0.0.1:0")
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer lis.Close()
+				go func() {
+					for {
+						conn, err := lis.Accept()
+						if err != nil {
+							return
+						}
+						defer conn.Close()
+					}
+				}()
+				serverURL = "https://" + lis.Addr().String()
+			} else {
+				srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					tt.handler(w, release)
+				}))
+				defer srv.Close()
+				serverURL = srv.URL
+			}
+			defer close(release)
+
+			c := NewClient(&Config{
+				APIKey:       "key",
+				Timeouts:     EndpointTimeouts{Culture: tt.timeouts},
+				Interceptors: []Interceptor{serverInterceptor(serverURL)},
+			})
+
+			ctx := context.Background()
+			if tt.deadline > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.deadline)
+				defer cancel()
+			}
+
+			_, errResp := c.Locales(ctx)
+			var tErr *TimeoutError
+			if !errors.As(errResp, &tErr) {
+				t.Fatalf("got %v, want a timeout error", errResp)
+			}
+			if tErr.Phase != tt.want || tErr.Endpoint != EndpointCulture {
+				t.Errorf("got %s timeout of %s, want %s timeout of %s", tErr.Phase, tErr.Endpoint, tt.want, EndpointCulture)
+			}
+			if !errors.Is(errResp, ErrTimeout) {
+				t.Error("error does not match ErrTimeout")
+			}
+		})
+	}
+}
+
+func TestConnectTimeoutPhase(t *testing.T) {
+	rt := newRequestTimeouts(context.Background(), EndpointPoll, Timeouts{Connect: testTimeout, Total: time.Hour})
+	defer rt.release()
+
+	trace := httptrace.ContextClientTrace(rt.ctx)
+	trace.ConnectStart("tcp", "192.0.2.1:443")
+	trace.ConnectStart("tcp6", "[2001:db8::1]:443")
+	trace.ConnectDone("tcp6", "[2001:db8::1]:443", errors.New("unreachable"))
+
+	select {
+	case <-rt.ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("the pending dial did not time out")
+	}
+
+	err := timeoutError(context.Background(), rt.ctx, EndpointPoll, rt.ctx.Err())
+	var tErr *TimeoutError
+	if !errors.As(err, &tErr) || tErr.Phase != TimeoutPhaseConnect || tErr.Timeout != testTimeout {
+		t.Errorf("got %v, want a connect timeout", err)
+	}
+}
+
+func TestClientReusesConnections(t *testing.T) {
+	var conns atomic.Int32
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"RESULT_STATUS_COMPLETE"}`))
+	}))
+	srv.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	srv.Start()
+	defer srv.Close()
+
+	c := NewClient(&Config{APIKey: "key", Interceptors: []Interceptor{serverInterceptor(srv.URL)}})
+	for i := 0; i < 3; i++ {
+		if _, errResp := c.Locales(context.Background()); errResp != nil {
+			t.Fatal(errResp)
+		}
+		if _, errResp := c.AutoSuggestFlights(context.Background(), &AutoSuggestFlightsRequest{}); errResp != nil {
+			t.Fatal(errResp)
+		}
+	}
+
+	if got := conns.Load(); got != 1 {
+		t.Errorf("requests used %d connections, want 1", got)
+	}
+}
@@ -0,0 +1,242 @@
+package skyscanner
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookIDHeader        = "X-Webhook-Id"
+	webhookSignaturePrefix = "sha256="
+	defaultWebhookRetries  = 3
+	defaultWebhookBackoff  = time.Second
+	defaultWebhookTimeout  = 10 * time.Second
+)
+
+// WebhookPayload is the JSON body posted by WebhookSink
+type WebhookPayload struct {
+	// ID is unique per event and is the same for all retries and URLs, so receivers can deduplicate
+	ID    string      `json:"id"`
+	Event SearchEvent `json:"event"`
+}
+
+// WebhookSink is a SearchHook posting events to the URLs as signed JSON payloads.
+// Deliveries run in the background and are retried with exponential backoff
+// on network errors, 429 and 5xx responses
+type WebhookSink struct {
+	URLs []string
+	// Secret signs payloads. The signature header is "sha256=" followed by
+	// hex HMAC-SHA256 of the timestamp header value, a dot and the body
+	Secret []byte
+	// HTTPClient sends deliveries. Default has a 10 seconds timeout
+	HTTPClient *http.Client
+	// MaxRetries is a number of retries after the first attempt. Default is 3
+	MaxRetries int
+	// Backoff is a delay before the first retry, doubled for every next one. Default is 1 second
+	Backoff time.Duration
+	// Events limits delivered event types. All events are delivered if empty
+	Events []SearchEventType
+	// OnError is called when a delivery to the URL finally fails
+	OnError func(url string, err error)
+
+	wg sync.WaitGroup
+	// mu guards closed and the context, so deliveries are not added while Close waits for them
+	mu     sync.Mutex
+	closed bool
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewWebhookSink returns a webhook sink with default delivery options
+func NewWebhookSink(secret string, urls ...string) *WebhookSink {
+	return &WebhookSink{
+		URLs:       urls,
+		Secret:     []byte(secret),
+		HTTPClient: &http.Client{Timeout: defaultWebhookTimeout},
+		MaxRetries: defaultWebhookRetries,
+		Backoff:    defaultWebhookBackoff,
+	}
+}
+
+// OnSearchEvent implements SearchHook. It does not wait for deliveries
+func (s *WebhookSink) OnSearchEvent(_ context.Context, e SearchEvent) {
+	if !s.accepts(e.Type) {
+		return
+	}
+
+	payload := WebhookPayload{ID: newWebhookID(), Event: e}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		s.fail("", errors.New("webhook payload marshalling error: "+err.Error()))
+		return
+	}
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	ctx := s.context()
+	s.wg.Add(len(s.URLs))
+	s.mu.Unlock()
+
+	for _, url := range s.URLs {
+		go func(url string) {
+			defer s.wg.Done()
+
+			if err := s.deliver(ctx, url, payload, body); err != nil {
+				s.fail(url, err)
+			}
+		}(url)
+	}
+}
+
+// Wait blocks until all the started deliveries are finished
+func (s *WebhookSink) Wait() {
+	s.wg.Wait()
+}
+
+// Close cancels the pending deliveries and retries and waits for them to return.
+// Events received after Close are not delivered
+func (s *WebhookSink) Close() {
+	s.mu.Lock()
+	s.closed = true
+	s.context()
+	s.mu.Unlock()
+
+	s.cancel()
+	s.wg.Wait()
+}
+
+// context returns the context of the deliveries, which outlive the search context and end on Close.
+// s.mu must be held
+func (s *WebhookSink) context() context.Context {
+	if s.ctx == nil {
+		s.ctx, s.cancel = context.WithCancel(context.Background())
+	}
+
+	return s.ctx
+}
+
+// SignWebhook returns the signature header value of the body sent with the timestamp header value
+func SignWebhook(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhook reports whether the signature matches the body and the timestamp
+// and the timestamp is not older than maxAge. Zero maxAge disables the age check
+func VerifyWebhook(secret []byte, timestamp, signature string, body []byte, maxAge time.Duration) bool {
+	if maxAge > 0 {
+		sec, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil || time.Since(time.Unix(sec, 0)) > maxAge {
+			return false
+		}
+	}
+
+	return hmac.Equal([]byte(signature), []byte(SignWebhook(secret, timestamp, body)))
+}
+
+func (s *WebhookSink) deliver(ctx context.Context, url string, payload WebhookPayload, body []byte) error {
+	httpClient := s.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultWebhookTimeout}
+	}
+	backoff := s.Backoff
+	if backoff <= 0 {
+		backoff = defaultWebhookBackoff
+	}
+
+	var err error
+	for attempt := 0; attempt <= s.MaxRetries; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return errors.New("webhook delivery canceled: " + err.Error())
+			case <-timer.C:
+			}
+			backoff *= 2
+		}
+
+		var retry bool
+		retry, err = s.post(ctx, httpClient, url, payload, body)
+		if err == nil || !retry {
+			return err
+		}
+	}
+
+	return err
+}
+
+// post sends the payload once and reports whether a failed delivery should be retried
+func (s *WebhookSink) post(ctx context.Context, httpClient *http.Client, url string, payload WebhookPayload, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.New("webhook request creating error: " + err.Error())
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookIDHeader, payload.ID)
+	req.Header.Set(WebhookEventHeader, string(payload.Event.Type))
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, SignWebhook(s.Secret, timestamp, body))
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return true, errors.New("webhook request doing error: " + err.Error())
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+
+	retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retry, errors.New("webhook bad response status: " + resp.Status)
+}
+
+func (s *WebhookSink) accepts(t SearchEventType) bool {
+	if len(s.Events) == 0 {
+		return true
+	}
+	for _, e := range s.Events {
+		if e == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *WebhookSink) fail(url string, err error) {
+	if s.OnError != nil {
+		s.OnError(url, err)
+	}
+}
+
+func newWebhookID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
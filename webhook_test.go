@@ -0,0 +1,99 @@
+package skyscanner
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWebhookSinkDeliversSignedEvents(t *testing.T) {
+	secret := []byte("secret")
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !VerifyWebhook(secret, r.Header.Get(WebhookTimestampHeader), r.Header.Get(WebhookSignatureHeader), body, time.Minute) {
+			t.Errorf("invalid signature of %s", body)
+		}
+		if r.Header.Get(WebhookEventHeader) != string(SearchEventCompleted) {
+			t.Errorf("unexpected event header %q", r.Header.Get(WebhookEventHeader))
+		}
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	sink := NewWebhookSink(string(secret), srv.URL)
+	sink.Backoff = time.Millisecond
+	sink.Events = []SearchEventType{SearchEventCompleted}
+	sink.OnError = func(url string, err error) {
+		t.Errorf("delivery to %s failed: %v", url, err)
+	}
+
+	sink.OnSearchEvent(context.Background(), SearchEvent{Type: SearchEventPollReceived})
+	sink.OnSearchEvent(context.Background(), SearchEvent{Type: SearchEventCompleted})
+	sink.Wait()
+
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("got %d attempts, want 2", got)
+	}
+}
+
+func TestWebhookSinkCloseCancelsRetries(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	failed := make(chan error, 1)
+	sink := NewWebhookSink("secret", srv.URL)
+	sink.Backoff = time.Hour
+	sink.OnError = func(_ string, err error) { failed <- err }
+
+	sink.OnSearchEvent(context.Background(), SearchEvent{Type: SearchEventCompleted})
+
+	done := make(chan struct{})
+	go func() {
+		sink.Close()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close did not cancel the retry")
+	}
+	if err := <-failed; err == nil {
+		t.Error("canceled delivery is not reported")
+	}
+
+	sink.OnSearchEvent(context.Background(), SearchEvent{Type: SearchEventCompleted})
+	select {
+	case err := <-failed:
+		t.Errorf("event after Close was delivered: %v", err)
+	default:
+	}
+}
+
+func TestWebhookSinkEventsDuringClose(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer srv.Close()
+
+	sink := NewWebhookSink("secret", srv.URL)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				sink.OnSearchEvent(context.Background(), SearchEvent{Type: SearchEventPollReceived})
+			}
+		}()
+	}
+	sink.Close()
+	wg.Wait()
+}
@@ -0,0 +1,40 @@
+package skyscanner
+
+import (
+	"context"
+	"sync"
+)
+
+// runWorkers calls fn for every index in [0, n) using at most workers goroutines.
+// Indexes not started before the context is done are skipped
+func runWorkers(ctx context.Context, workers, n int, fn func(i int)) {
+	if workers <= 0 {
+		workers = 1
+	}
+	if workers > n {
+		workers = n
+	}
+
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+
+feed:
+	for i := 0; i < n; i++ {
+		select {
+		case <-ctx.Done():
+			break feed
+		case jobs <- i:
+		}
+	}
+	close(jobs)
+	wg.Wait()
+}